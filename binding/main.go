@@ -13,12 +13,13 @@ import (
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
 )
 
 // ParseToString function takes a name-string, desired format, a withDetails
 // flag as 0|1 integer. It parses the name-string to either JSON, or a CSV
 // string, depending on the desired format. Format argument can take values of
-// 'csv', 'compact', 'pretty'. If withDetails argument is 0, additional
+// 'csv', 'dwc', 'html', 'markdown', 'compact', 'pretty'. If withDetails argument is 0, additional
 // parsed details are ommited, if it is 1 -- they are included.
 // true.
 //export ParseToString
@@ -52,7 +53,7 @@ func FreeMemory(p *C.char) {
 // ParseAryToString function takes an array of names, parsing format, and a
 // withDetails flag as 0|1 integer.  Parsed outputs are sent as a string in
 // either CSV or JSON format.  Format argument can take values of 'csv',
-// 'compact', or 'pretty'. For withDetails argument 0 means false, 1 means
+// 'dwc', 'html', 'markdown', 'compact', or 'pretty'. For withDetails argument 0 means false, 1 means
 // true.
 //export ParseAryToString
 func ParseAryToString(
@@ -85,15 +86,16 @@ func ParseAryToString(
 	gnp := gnparser.New(cfg)
 
 	var res string
-	parsed := gnp.ParseNames(names)
-	if gnp.Format() == gnfmt.CSV {
+	ps := gnp.ParseNames(names)
+	switch f := gnp.Format(); f {
+	case gnfmt.CSV, parsed.DwC, parsed.HTML, parsed.Markdown:
 		csv := make([]string, length)
-		for i := range parsed {
-			csv[i] = parsed[i].Output(gnfmt.CSV)
+		for i := range ps {
+			csv[i] = ps[i].Output(f)
 		}
 		res = strings.Join(csv, "\n")
-	} else {
-		json, _ := gnfmt.GNjson{}.Encode(parsed)
+	default:
+		json, _ := gnfmt.GNjson{}.Encode(ps)
 		res = string(json)
 	}
 	return C.CString(res)
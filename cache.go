@@ -0,0 +1,117 @@
+package gnparser
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// CacheStats provides information about the usage of the cache of parsed
+// results.
+type CacheStats struct {
+	// Hits is the number of name-strings that were found in the cache.
+	Hits uint64 `json:"hits"`
+
+	// Misses is the number of name-strings that had to be parsed.
+	Misses uint64 `json:"misses"`
+
+	// Size is the current number of results in the cache.
+	Size int `json:"size"`
+
+	// Capacity is the maximum number of results the cache can keep.
+	Capacity int `json:"capacity"`
+}
+
+// cacheKey combines a name-string with settings that change the
+// parsing output.
+type cacheKey struct {
+	name           string
+	withDetails    bool
+	withCultivars  bool
+	withDiaereses  bool
+	withCapitalize bool
+	ignoreHTML     bool
+	isTest         bool
+	code           nomcode.Code
+}
+
+type cacheEntry struct {
+	key cacheKey
+	res parsed.Parsed
+}
+
+// cache is a bounded least-recently-used cache of parsed results.
+// It is safe for concurrent use.
+type cache struct {
+	sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[cacheKey]*list.Element
+	hits     uint64
+	misses   uint64
+}
+
+func newCache(capacity int) *cache {
+	if capacity <= 0 {
+		return nil
+	}
+	return &cache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[cacheKey]*list.Element),
+	}
+}
+
+func newCacheKey(s string, cfg Config) cacheKey {
+	return cacheKey{
+		name:           s,
+		withDetails:    cfg.detailsRequired(),
+		withCultivars:  cfg.WithCultivars,
+		withDiaereses:  cfg.WithPreserveDiaereses,
+		withCapitalize: cfg.WithCapitalization,
+		ignoreHTML:     cfg.IgnoreHTMLTags,
+		isTest:         cfg.IsTest,
+		code:           cfg.Code,
+	}
+}
+
+func (c *cache) get(k cacheKey) (parsed.Parsed, bool) {
+	c.Lock()
+	defer c.Unlock()
+	if e, ok := c.items[k]; ok {
+		c.hits++
+		c.ll.MoveToFront(e)
+		return e.Value.(*cacheEntry).res, true
+	}
+	c.misses++
+	return parsed.Parsed{}, false
+}
+
+func (c *cache) add(k cacheKey, res parsed.Parsed) {
+	c.Lock()
+	defer c.Unlock()
+	if e, ok := c.items[k]; ok {
+		c.ll.MoveToFront(e)
+		e.Value.(*cacheEntry).res = res
+		return
+	}
+	c.items[k] = c.ll.PushFront(&cacheEntry{key: k, res: res})
+	if c.ll.Len() > c.capacity {
+		e := c.ll.Back()
+		c.ll.Remove(e)
+		delete(c.items, e.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *cache) stats() CacheStats {
+	c.Lock()
+	defer c.Unlock()
+	return CacheStats{
+		Hits:     c.hits,
+		Misses:   c.misses,
+		Size:     c.ll.Len(),
+		Capacity: c.capacity,
+	}
+}
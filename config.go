@@ -5,16 +5,23 @@ import (
 	"runtime"
 
 	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
 )
 
 // Config keeps settings that might affect how parsing is done,
 // of change the parsing output.
 type Config struct {
 	// Format sets the output format for CLI and Web interfaces.
-	// There are 3 formats available: 'CSV', 'CompactJSON' and
-	// 'PrettyJSON'.
+	// There are 7 formats available: 'CSV', 'TSV', 'CompactJSON',
+	// 'PrettyJSON', 'DwC' (Darwin Core), 'HTML' and 'Markdown'.
 	Format gnfmt.Format
 
+	// Fields sets columns of CSV and TSV outputs. If it is empty,
+	// parsed.DefaultFields are used. Fields that are taken from Details
+	// or Words make parsing to create details.
+	Fields []parsed.Field
+
 	// JobsNum sets a level of parallelism used during parsing of
 	// a stream of name-strings.
 	JobsNum int
@@ -22,6 +29,12 @@ type Config struct {
 	// BatchSize sets the maximum number of elements in names-strings slice.
 	BatchSize int
 
+	// CacheSize sets the maximum number of parsed results kept in a
+	// least-recently-used cache. When it is 0 the cache is disabled.
+	// Cached results are shared between calls and should not be
+	// modified.
+	CacheSize int
+
 	// WithStream changes from parsing a batch by batch, to parsing one name
 	// at a time. When WithStream is true, BatchSize setting is ignored.
 	WithStream bool
@@ -32,7 +45,8 @@ type Config struct {
 	IgnoreHTMLTags bool
 
 	// WithDetails can be set to true when a simplified output is not sufficient
-	// for obtaining a required information.
+	// for obtaining a required information. Details are always created for
+	// DwC, HTML and Markdown formats.
 	WithDetails bool
 
 	// WithNoOrder flag, when true, output and input are in different order.
@@ -49,6 +63,13 @@ type Config struct {
 	// modify cardinality, normalized and canonical output.
 	WithCultivars bool
 
+	// Code sets the nomenclatural code of names. When the code is known,
+	// it is used to resolve ambiguities, for example `f.` is always
+	// 'forma' for botanical names, and a word in parentheses after a genus
+	// is always a subgenus for zoological names. By default the code is
+	// Unknown and ambiguities are resolved automatically.
+	Code nomcode.Code
+
 	// Port to run wer-service.
 	Port int
 
@@ -77,6 +98,25 @@ func OptBatchSize(i int) Option {
 	}
 }
 
+// OptCacheSize sets the maximum number of parsed results to keep in
+// the cache. Zero disables the cache.
+func OptCacheSize(i int) Option {
+	return func(cfg *Config) {
+		if i < 0 {
+			log.Println("Cache size should not be a negative number")
+			return
+		}
+		cfg.CacheSize = i
+	}
+}
+
+// OptCode sets the nomenclatural code of names.
+func OptCode(c nomcode.Code) Option {
+	return func(cfg *Config) {
+		cfg.Code = c
+	}
+}
+
 // OptDebugParse returns parsed tree
 func OptDebug(b bool) Option {
 	return func(cfg *Config) {
@@ -84,12 +124,23 @@ func OptDebug(b bool) Option {
 	}
 }
 
-// OptFormat takes a string (one of 'csv', 'compact', 'pretty') to set
-// the formatting option for the CLI or Web presentation. If some other
-// string is entered, the default, 'CSV' format is set, accompanied by a
-// warning.
+// OptFormat takes a string (one of 'csv', 'tsv', 'compact', 'pretty',
+// 'dwc', 'html', 'markdown') to set the formatting option for the CLI or Web presentation. If
+// some other string is entered, the default, 'CSV' format is set,
+// accompanied by a warning.
 func OptFormat(s string) Option {
 	return func(cfg *Config) {
+		switch s {
+		case "dwc":
+			cfg.Format = parsed.DwC
+			return
+		case "html":
+			cfg.Format = parsed.HTML
+			return
+		case "markdown":
+			cfg.Format = parsed.Markdown
+			return
+		}
 		f, err := gnfmt.NewFormat(s)
 		if err != nil {
 			f = gnfmt.CSV
@@ -99,6 +150,13 @@ func OptFormat(s string) Option {
 	}
 }
 
+// OptFields sets columns of CSV and TSV outputs.
+func OptFields(fs []parsed.Field) Option {
+	return func(cfg *Config) {
+		cfg.Fields = fs
+	}
+}
+
 // OptKeepHTMLTags sets the KeepHTMLTags field. This option is useful if
 // names with HTML tags shold not be parsed, or they are absent in input
 // data.
@@ -171,6 +229,16 @@ func OptWithStream(b bool) Option {
 	}
 }
 
+// detailsRequired is true if parsing results need details, either because
+// of WithDetails setting, or because the output format or fields use them.
+func (cfg Config) detailsRequired() bool {
+	switch cfg.Format {
+	case parsed.DwC, parsed.HTML, parsed.Markdown:
+		return true
+	}
+	return cfg.WithDetails || parsed.FieldsNeedDetails(cfg.Fields)
+}
+
 // NewConfig generates a new Config object. It can take an arbitrary number
 // of `Option` functions to modify default configuration settings.
 func NewConfig(opts ...Option) Config {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,8 +29,10 @@ func TestNewOpts(t *testing.T) {
 	cnf := gnparser.NewConfig(opts...)
 	updt := gnparser.Config{
 		Format:         gnfmt.CompactJSON,
+		Fields:         []parsed.Field{parsed.IDField, parsed.GenusField},
 		JobsNum:        161,
 		BatchSize:      1,
+		CacheSize:      100,
 		IgnoreHTMLTags: true,
 		WithDetails:    true,
 		Port:           8989,
@@ -40,8 +43,10 @@ func TestNewOpts(t *testing.T) {
 func opts() []gnparser.Option {
 	return []gnparser.Option{
 		gnparser.OptFormat("compact"),
+		gnparser.OptFields([]parsed.Field{parsed.IDField, parsed.GenusField}),
 		gnparser.OptJobsNum(161),
 		gnparser.OptBatchSize(1),
+		gnparser.OptCacheSize(100),
 		gnparser.OptIgnoreHTMLTags(true),
 		gnparser.OptWithDetails(true),
 		gnparser.OptPort(8989),
@@ -8,6 +8,8 @@ import (
 	"regexp"
 	"strings"
 	"unicode"
+
+	"github.com/gnames/gnparser/ent/parsed"
 )
 
 var VirusException = map[string]string{
@@ -76,6 +78,18 @@ var taxonConceptsRe2 = regexp.MustCompile(
 var taxonConceptsRe3 = regexp.MustCompile(
 	`(?i)(,\s*|\s+)(pro parte|p\.\s?p\.)\s*$`,
 )
+
+// novRe finds new taxa annotations like "sp. nov.". They are cut off
+// only after binomials and names with authors, because "Aus sp. nov."
+// is an approximation name.
+var novRe = regexp.MustCompile(
+	`(?i)(,\s*|\s+)(sp|spec|gen|subsp|ssp|var)\.?\s*nov\.?(\s.*)?$`,
+)
+
+var strainsRe = regexp.MustCompile(
+	`\s+((ATCC|DSMZ?|NCTC|NCIMB|JCM|LMG|CIP|NRRL|CCUG|NBRC|KCTC|CECT|ICMP|NCPPB)` +
+		`[\s:-]?\d|(bv\.|biovar)\s).*$`,
+)
 var nomenConceptsRe = regexp.MustCompile(
 	`(?i)(,\s*|\s+)(\(?(nomen|nom\.|comb\.)(\s.*)?)$`,
 )
@@ -111,6 +125,10 @@ type Preprocessor struct {
 	Body        []byte
 	Tail        []byte
 	Ambiguous   ambiguous
+
+	// VirusCategory is a category of a sub-cellular entity, it is set
+	// when Virus is true.
+	VirusCategory parsed.VirusCategory
 }
 
 type ambiguous struct {
@@ -136,6 +154,10 @@ func Preprocess(bs []byte) *Preprocessor {
 		pr.Virus = IsVirus(bs[0:i])
 	}
 	if pr.Virus {
+		pr.VirusCategory = VirusCategory(bs[0:i])
+		if pr.VirusCategory == parsed.NoVirusCat {
+			pr.VirusCategory = parsed.VirusCat
+		}
 		pr.NoParse = true
 		return pr
 	}
@@ -146,6 +168,7 @@ func Preprocess(bs []byte) *Preprocessor {
 		pr.NoParse = false
 	}
 	if pr.NoParse {
+		pr.subCellular(bs[0:i])
 		return pr
 	}
 
@@ -219,7 +242,7 @@ func procAnnot(bs []byte) int {
 	i := len(bs)
 	regexps := []*regexp.Regexp{
 		notesRe, taxonConceptsRe1, taxonConceptsRe2, taxonConceptsRe3,
-		nomenConceptsRe, lastWordJunkRe, stopWordsRe,
+		nomenConceptsRe, lastWordJunkRe, stopWordsRe, strainsRe,
 	}
 	for _, r := range regexps {
 		loc := r.FindIndex(bs[0:i])
@@ -228,6 +251,11 @@ func procAnnot(bs []byte) int {
 		}
 	}
 
+	loc := novRe.FindIndex(bs[0:i])
+	if len(loc) > 0 && bytes.Contains(bytes.TrimSpace(bs[0:loc[0]]), []byte(" ")) {
+		i = loc[0]
+	}
+
 	// If ` of ` is in the string, before the start of the already-calculated
 	// unparsed part, but there is no cultivar rank marker before it, consider it
 	// unparseable. `Anthurium 'Ace of Spades'` should parse fully;
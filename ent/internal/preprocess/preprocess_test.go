@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -114,6 +115,37 @@ func TestPreprocess(t *testing.T) {
 		}
 	})
 
+	t.Run("VirusCategory", func(t *testing.T) {
+		data := []struct {
+			msg   string
+			name  string
+			virus bool
+			cat   parsed.VirusCategory
+		}{
+			{"No match", "Homo sapiens", false, parsed.NoVirusCat},
+			{"Virus", "Turtle herpesviruses", true, parsed.VirusCat},
+			{"NPV", "Uranotaenia sapphirina NPV", true, parsed.VirusCat},
+			{"Phage", "Aeromonas phage 65", true, parsed.BacteriophageCat},
+			{"Phage2", "Escherichia coli bacteriophage", true,
+				parsed.BacteriophageCat},
+			{"Satellite", "Bemisia betasatellite LW-2014", true,
+				parsed.SatelliteCat},
+			{"Viroid", "Apple scar skin viroid", true, parsed.ViroidCat},
+			{"Prion", "Fungal prions", true, parsed.PrionCat},
+			{"Vector", "Cre expression vector", true, parsed.VectorCat},
+			{"Plasmid", "Gateway destination plasmid", true, parsed.PlasmidCat},
+			{"RNA", "E. coli mRNA", true, parsed.NucleicAcidCat},
+			{"RNA2", "ssRNA", true, parsed.NucleicAcidCat},
+			{"RNA3", "Alpha proteobacterium RNA12", false, parsed.NoVirusCat},
+			{"Bacterium", "Alpha proteobacterium", false, parsed.NoVirusCat},
+		}
+		for _, v := range data {
+			res := Preprocess([]byte(v.name))
+			assert.Equal(t, res.Virus, v.virus, v.msg)
+			assert.Equal(t, res.VirusCategory, v.cat, v.msg)
+		}
+	})
+
 	t.Run("NoParse", func(t *testing.T) {
 		data := []struct {
 			msg    string
@@ -161,6 +193,12 @@ func TestPreprocess(t *testing.T) {
 			{"No tail", "Homo sapiens s. s.", "Homo sapiens", " s. s."},
 			{"No tail", "Homo sapiens sensu Linn.", "Homo sapiens", " sensu Linn."},
 			{"No tail", "Homo sapiens nomen nudum", "Homo sapiens", " nomen nudum"},
+			{"Collection", "Bacillus subtilis DSM 10", "Bacillus subtilis", " DSM 10"},
+			{"Collection2", "Bacillus sp. ATCC12345", "Bacillus sp.", " ATCC12345"},
+			{"New species", "Aus bus Smith sp. nov.", "Aus bus Smith", " sp. nov."},
+			{"Approximation", "Aus sp. nov.", "Aus sp. nov.", ""},
+			{"Biovar", "Rhizobium leguminosarum bv. viciae",
+				"Rhizobium leguminosarum", " bv. viciae"},
 		}
 		for _, v := range data {
 			bs := []byte(v.in)
@@ -0,0 +1,55 @@
+package preprocess
+
+import (
+	"regexp"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// virusCategories are checked in order, the first match determines the
+// category of a name.
+var virusCategories = []struct {
+	re  *regexp.Regexp
+	cat parsed.VirusCategory
+}{
+	{regexp.MustCompile(`(?i)(^|[^\p{L}])vectors?([^\p{L}]|$)`), parsed.VectorCat},
+	{regexp.MustCompile(`(?i)plasmids?([^\p{L}]|$)`), parsed.PlasmidCat},
+	{regexp.MustCompile(`(?i)(^|[^\p{L}])prions?([^\p{L}]|$)`), parsed.PrionCat},
+	{regexp.MustCompile(`(?i)(^|[^\p{L}])viroids?([^\p{L}]|$)`), parsed.ViroidCat},
+	{
+		regexp.MustCompile(`(?i)(^|[^\p{L}])(alpha|beta)?satellites?([^\p{L}]|$)`),
+		parsed.SatelliteCat,
+	},
+	{
+		regexp.MustCompile(`(?i)(^|[^\p{L}])(cyano|bacterio)?phages?([^\p{L}]|$)`),
+		parsed.BacteriophageCat,
+	},
+}
+
+var nucleicAcidRe = regexp.MustCompile(
+	`(^|[^\p{L}])(ss|ds|m|t|r|sn|mi)?(RNA|DNA)([^\p{L}\d_]|$)`,
+)
+
+// VirusCategory determines a category of a name of a sub-cellular entity.
+// It returns parsed.NoVirusCat if no category is found.
+func VirusCategory(bs []byte) parsed.VirusCategory {
+	for _, v := range virusCategories {
+		if v.re.Match(bs) {
+			return v.cat
+		}
+	}
+	return parsed.NoVirusCat
+}
+
+// subCellular checks if a name, that cannot be parsed, is a name of
+// a plasmid, or a nucleic acid.
+func (p *Preprocessor) subCellular(bs []byte) {
+	cat := VirusCategory(bs)
+	if cat == parsed.NoVirusCat && nucleicAcidRe.Match(bs) {
+		cat = parsed.NucleicAcidCat
+	}
+	if cat != parsed.NoVirusCat {
+		p.Virus = true
+		p.VirusCategory = cat
+	}
+}
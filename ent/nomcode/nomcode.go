@@ -0,0 +1,100 @@
+// Package nomcode provides nomenclatural codes that regulate scientific
+// names. Knowing the code of a name helps to resolve ambiguities during
+// parsing.
+package nomcode
+
+import (
+	"errors"
+	"strings"
+)
+
+// Code is a nomenclatural code.
+type Code int
+
+const (
+	// Unknown means that the code is not known, and parser decides
+	// ambiguous cases automatically.
+	Unknown Code = iota
+	// Zoological is the International Code of Zoological Nomenclature.
+	Zoological
+	// Botanical is the International Code of Nomenclature for algae, fungi,
+	// and plants.
+	Botanical
+	// Bacterial is the International Code of Nomenclature of Prokaryotes.
+	Bacterial
+	// Virus is the International Code of Virus Classification and
+	// Nomenclature.
+	Virus
+	// Cultivars is the International Code of Nomenclature for Cultivated
+	// Plants.
+	Cultivars
+)
+
+var codeMap = map[Code]string{
+	Unknown:    "",
+	Zoological: "ICZN",
+	Botanical:  "ICN",
+	Bacterial:  "ICNP",
+	Virus:      "ICVCN",
+	Cultivars:  "ICNCP",
+}
+
+var codeStrMap = func() map[string]Code {
+	res := make(map[string]Code)
+	for k, v := range codeMap {
+		res[v] = k
+	}
+	return res
+}()
+
+var codeAliases = map[string]Code{
+	"zoo":        Zoological,
+	"zoological": Zoological,
+	"bot":        Botanical,
+	"botanical":  Botanical,
+	"bac":        Bacterial,
+	"bacterial":  Bacterial,
+	"vir":        Virus,
+	"virus":      Virus,
+	"cult":       Cultivars,
+	"cultivar":   Cultivars,
+	"cultivars":  Cultivars,
+	"cultivated": Cultivars,
+}
+
+// New takes a string and returns the corresponding code. It accepts
+// abbreviations of codes (e.g. 'ICZN', 'ICN') as well as their informal
+// names (e.g. 'zoological', 'bot'). The case of the string is ignored.
+// If the string is not recognized, Unknown code is returned.
+func New(s string) Code {
+	s = strings.TrimSpace(s)
+	if c, ok := codeStrMap[strings.ToUpper(s)]; ok {
+		return c
+	}
+	if c, ok := codeAliases[strings.ToLower(s)]; ok {
+		return c
+	}
+	return Unknown
+}
+
+// String is an implementation of fmt.Stringer interface.
+func (c Code) String() string {
+	return codeMap[c]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Code) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + c.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (c *Code) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*c, ok = codeStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode Code")
+	}
+	return err
+}
@@ -0,0 +1,61 @@
+package nomcode_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		msg, in string
+		code    nomcode.Code
+	}{
+		{"empty", "", nomcode.Unknown},
+		{"junk", "something", nomcode.Unknown},
+		{"iczn", "ICZN", nomcode.Zoological},
+		{"iczn low", "iczn", nomcode.Zoological},
+		{"zoo", "zoological", nomcode.Zoological},
+		{"icn", "ICN", nomcode.Botanical},
+		{"bot", "Bot", nomcode.Botanical},
+		{"icnp", "icnp", nomcode.Bacterial},
+		{"bac", "bacterial", nomcode.Bacterial},
+		{"icvcn", "ICVCN", nomcode.Virus},
+		{"virus", "virus", nomcode.Virus},
+		{"icncp", "ICNCP", nomcode.Cultivars},
+		{"cult", "cultivars", nomcode.Cultivars},
+	}
+
+	for _, v := range tests {
+		assert.Equal(t, nomcode.New(v.in), v.code, v.msg)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type dataOb struct {
+		Code nomcode.Code `json:"code,omitempty"`
+	}
+	tests := []struct {
+		dob dataOb
+		res string
+	}{
+		{dataOb{nomcode.Unknown}, `{}`},
+		{dataOb{nomcode.Zoological}, `{"code":"ICZN"}`},
+		{dataOb{nomcode.Cultivars}, `{"code":"ICNCP"}`},
+	}
+	enc := gnfmt.GNjson{}
+	for _, v := range tests {
+		var dob dataOb
+		res, err := enc.Encode(v.dob)
+		assert.Nil(t, err)
+		assert.Equal(t, string(res), v.res)
+		err = enc.Decode(res, &dob)
+		assert.Nil(t, err)
+		assert.Equal(t, dob, v.dob)
+	}
+	var c nomcode.Code
+	err := enc.Decode([]byte(`"ICBN"`), &c)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,321 @@
+package parsed
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gnames/gnparser/ent/str"
+	"github.com/gnames/gnparser/io/dict"
+)
+
+// YearTolerance is the largest difference between years of two
+// authorships that are still considered compatible.
+const YearTolerance = 1
+
+// AuthMatch is a result of comparison of two authorships.
+type AuthMatch struct {
+	// Grade tells how well the authorships match.
+	Grade MatchGrade `json:"grade"`
+	// Explanation describes the reasons of the grade.
+	Explanation string `json:"explanation"`
+}
+
+// CompareAuthorship compares two authorships and decides if they refer
+// to the same authors. Original and combination authors are compared
+// separately. Abbreviations are treated as prefixes of full surnames
+// ("Mill." matches "Miller"), diacritics are ignored, years match if they
+// differ not more than by YearTolerance. Ex- and emend- authors are not
+// compared.
+//
+// Authorships parsed with details give better results. Without details
+// it is not possible to separate original authors from combination
+// authors, and all authors are compared as one group.
+func CompareAuthorship(a, b *Authorship) AuthMatch {
+	if a == nil || b == nil {
+		return AuthMatch{
+			Grade:       UnknownMatch,
+			Explanation: "authorship is missing",
+		}
+	}
+
+	origA, combA := authGroups(a)
+	origB, combB := authGroups(b)
+	grade, expl := compareAuthGroups("original", origA, origB)
+	res := AuthMatch{Grade: grade, Explanation: expl}
+
+	switch {
+	case combA == nil && combB == nil:
+		return res
+	case combA == nil || combB == nil:
+		grade = PartialMatch
+		expl = "combination authors are missing in one authorship"
+	default:
+		grade, expl = compareAuthGroups("combination", combA, combB)
+	}
+	if grade < res.Grade {
+		res.Grade = grade
+	}
+	res.Explanation += "; " + expl
+	return res
+}
+
+// authGroups returns original and combination authors of an authorship.
+// If the authorship has no details, all its authors are returned as
+// original ones.
+func authGroups(au *Authorship) (*AuthGroup, *AuthGroup) {
+	if au.Original != nil {
+		return au.Original, au.Combination
+	}
+	ag := AuthGroup{Authors: au.Authors}
+	if au.Year != "" {
+		yr := strings.Trim(au.Year, "()")
+		ag.Year = &Year{Value: yr, IsApproximate: yr != au.Year}
+	}
+	return &ag, nil
+}
+
+// compareAuthGroups compares authors and years of two groups.
+func compareAuthGroups(
+	group string,
+	a, b *AuthGroup,
+) (MatchGrade, string) {
+	grade, reasons := compareAuthorTeams(authorNames(a), authorNames(b))
+	yrGrade, yrReason := compareYears(a.Year, b.Year)
+	if yrReason != "" {
+		reasons = append(reasons, yrReason)
+	}
+	switch {
+	case yrGrade == ConflictingMatch && grade >= CompatibleMatch:
+		grade = PartialMatch
+	case yrGrade < grade:
+		grade = yrGrade
+	}
+
+	res := fmt.Sprintf("%s authors are %s", group, strings.ToLower(grade.String()))
+	if len(reasons) > 0 {
+		res += " (" + strings.Join(reasons, ", ") + ")"
+	}
+	return grade, res
+}
+
+// compareAuthorTeams finds the best match for every author of the first
+// team among authors of the second team.
+func compareAuthorTeams(a, b []authorName) (MatchGrade, []string) {
+	var reasons []string
+	if len(a) == 0 && len(b) == 0 {
+		return IdenticalMatch, reasons
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return UnknownMatch, append(reasons, "no authors to compare")
+	}
+
+	used := make([]bool, len(b))
+	var identical, compatible int
+	for i := range a {
+		best, idx := ConflictingMatch, -1
+		for j := range b {
+			if used[j] {
+				continue
+			}
+			if g := compareAuthors(a[i], b[j]); g > best {
+				best, idx = g, j
+			}
+		}
+		switch best {
+		case IdenticalMatch:
+			identical++
+		case CompatibleMatch:
+			compatible++
+			reasons = append(reasons,
+				fmt.Sprintf("%q matches %q", a[i].value, b[idx].value))
+		default:
+			reasons = append(reasons, fmt.Sprintf("%q has no match", a[i].value))
+			continue
+		}
+		used[idx] = true
+	}
+
+	matched := identical + compatible
+	switch {
+	case len(a) == len(b) && identical == len(a):
+		return IdenticalMatch, reasons
+	case len(a) == len(b) && matched == len(a):
+		return CompatibleMatch, reasons
+	case matched > 0:
+		if len(a) != len(b) {
+			reasons = append(reasons, "numbers of authors differ")
+		}
+		return PartialMatch, reasons
+	default:
+		return ConflictingMatch, reasons
+	}
+}
+
+// compareYears compares years of publication. It returns a grade and
+// a reason, if the years are not identical.
+func compareYears(a, b *Year) (MatchGrade, string) {
+	switch {
+	case a == nil && b == nil:
+		return IdenticalMatch, ""
+	case a == nil || b == nil:
+		return CompatibleMatch, "year is missing in one authorship"
+	case a.Value == b.Value:
+		return IdenticalMatch, ""
+	}
+
+	startA, endA := yearRange(a)
+	startB, endB := yearRange(b)
+	if startA == 0 || startB == 0 {
+		return CompatibleMatch,
+			fmt.Sprintf("years %s and %s cannot be compared", a.Value, b.Value)
+	}
+	var diff int
+	switch {
+	case startA > endB:
+		diff = startA - endB
+	case startB > endA:
+		diff = startB - endA
+	}
+	if diff <= YearTolerance {
+		return CompatibleMatch,
+			fmt.Sprintf("years %s and %s are close", a.Value, b.Value)
+	}
+	return ConflictingMatch,
+		fmt.Sprintf("years %s and %s differ", a.Value, b.Value)
+}
+
+// yearRange returns the first and the last years of a Year. If they are
+// not set, they are taken from the Value.
+func yearRange(yr *Year) (int, int) {
+	if yr.Start > 0 {
+		if yr.End < yr.Start {
+			return yr.Start, yr.Start
+		}
+		return yr.Start, yr.End
+	}
+	res, err := strconv.Atoi(yr.Value)
+	if err != nil {
+		return 0, 0
+	}
+	return res, res
+}
+
+// authorToken is a normalized word of an author's name.
+type authorToken struct {
+	val  string
+	abbr bool
+}
+
+// authorName is a name of an author prepared for comparison.
+type authorName struct {
+	value string
+	key   string
+	// inDict is true if the author is found in the dictionary of
+	// authors' abbreviations.
+	inDict bool
+	tokens []authorToken
+	filius bool
+}
+
+// authorNames prepares authors of a group for comparison. Keys of authors
+// are taken from AuthorDetails, if they are given.
+func authorNames(ag *AuthGroup) []authorName {
+	res := make([]authorName, len(ag.Authors))
+	for i, v := range ag.Authors {
+		res[i] = newAuthorName(v)
+		if len(ag.AuthorDetails) == len(ag.Authors) {
+			res[i].key = ag.AuthorDetails[i].Key
+			_, res[i].inDict = dict.Dict.StandardAuthor(v)
+		}
+	}
+	return res
+}
+
+// newAuthorName breaks an author's name into normalized tokens. Particles
+// ("de", "van") and "et al." are ignored, filius is kept as a flag.
+func newAuthorName(s string) authorName {
+	res := authorName{value: s}
+	var raw []string
+	var abbr []bool
+	var tok strings.Builder
+	flush := func(isAbbr bool) {
+		if tok.Len() > 0 {
+			raw = append(raw, tok.String())
+			abbr = append(abbr, isAbbr)
+			tok.Reset()
+		}
+	}
+	for _, v := range s {
+		switch v {
+		case '.':
+			flush(true)
+		case ' ', '-':
+			flush(false)
+		default:
+			tok.WriteRune(v)
+		}
+	}
+	flush(false)
+
+	for i, v := range raw {
+		val := strings.ToLower(str.Normalize(v))
+		switch {
+		case val == "f" || val == "fil" || val == "filius":
+			res.filius = true
+			continue
+		case val == "et" || val == "al":
+			continue
+		case i < len(raw)-1 && unicode.IsLower([]rune(v)[0]):
+			continue
+		}
+		t := authorToken{val: val, abbr: abbr[i] || len([]rune(val)) == 1}
+		res.tokens = append(res.tokens, t)
+	}
+	return res
+}
+
+// compareAuthors compares two authors. Surnames are compared from the end,
+// initials are compared only if both authors have them. Authors found in
+// the dictionary with different keys are different authors, even if one
+// name is a prefix of the other ("L." and "Lamarck").
+func compareAuthors(a, b authorName) MatchGrade {
+	if a.value == b.value {
+		return IdenticalMatch
+	}
+	if a.key != "" && a.key == b.key {
+		return CompatibleMatch
+	}
+	if a.inDict && b.inDict && a.key != "" && b.key != "" {
+		return ConflictingMatch
+	}
+	if a.filius != b.filius || len(a.tokens) == 0 || len(b.tokens) == 0 {
+		return ConflictingMatch
+	}
+	i, j := len(a.tokens)-1, len(b.tokens)-1
+	for ; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if !matchTokens(a.tokens[i], b.tokens[j]) {
+			return ConflictingMatch
+		}
+	}
+	return CompatibleMatch
+}
+
+// matchTokens checks if tokens are the same, or if an abbreviated token
+// is a prefix of the other, full token. Two different abbreviations do not
+// match, because "L." and "Lam." are different authors.
+func matchTokens(a, b authorToken) bool {
+	switch {
+	case a.val == b.val:
+		return true
+	case a.abbr && b.abbr:
+		return false
+	case a.abbr:
+		return strings.HasPrefix(b.val, a.val)
+	case b.abbr:
+		return strings.HasPrefix(a.val, b.val)
+	default:
+		return false
+	}
+}
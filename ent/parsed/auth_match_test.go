@@ -0,0 +1,91 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareAuthorship(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	gnpSimple := gnparser.New(gnparser.NewConfig())
+	tests := []struct {
+		msg, name1, name2 string
+		details           bool
+		grade             parsed.MatchGrade
+	}{
+		{"identical", "Aus bus (L.) Mill.", "Aus bus (L.) Mill.", true,
+			parsed.IdenticalMatch},
+		{"abbr", "Aus bus (L.) Mill.", "Aus bus (Linnaeus) Miller, 1768", true,
+			parsed.CompatibleMatch},
+		{"dict", "Aus bus Linn.", "Aus bus L.", true, parsed.CompatibleMatch},
+		{"dict different", "Aus bus L.", "Aus bus Lamarck", true,
+			parsed.ConflictingMatch},
+		{"dict different abbr", "Aus bus L.", "Aus bus Lam.", true,
+			parsed.ConflictingMatch},
+		{"dict different prefix", "Aus bus Mill.", "Aus bus Millspaugh", true,
+			parsed.ConflictingMatch},
+		{"no dict", "Aus bus Linn.", "Aus bus L.", false,
+			parsed.ConflictingMatch},
+		{"diacritics", "Aus bus Müll. 1850", "Aus bus Mueller 1850", true,
+			parsed.CompatibleMatch},
+		{"initials", "Aus bus R. Br.", "Aus bus Brown", true,
+			parsed.CompatibleMatch},
+		{"filius", "Aus bus Hook. f.", "Aus bus Hook.", true,
+			parsed.ConflictingMatch},
+		{"close years", "Aus bus Smith, 1900", "Aus bus Smith, 1901", true,
+			parsed.CompatibleMatch},
+		{"far years", "Aus bus Smith, 1900", "Aus bus Smith, 1910", true,
+			parsed.PartialMatch},
+		{"year range", "Aus bus Smith, 1900-1905", "Aus bus Smith, 1906", true,
+			parsed.CompatibleMatch},
+		{"year question", "Aus bus Smith, 190?", "Aus bus Smith, 1907", true,
+			parsed.CompatibleMatch},
+		{"no combination", "Aus bus (L.) Mill.", "Aus bus (L.)", true,
+			parsed.PartialMatch},
+		{"team", "Aus bus Smith & Jones", "Aus bus Smith", true,
+			parsed.PartialMatch},
+		{"different", "Aus bus (L.) Mill.", "Aus bus (Lam.) Mill.", true,
+			parsed.ConflictingMatch},
+		{"no details", "Aus bus (L.) Mill.", "Aus bus (Linnaeus) Miller", false,
+			parsed.CompatibleMatch},
+		{"no authorship", "Aus bus", "Aus bus L.", true, parsed.UnknownMatch},
+	}
+
+	for _, v := range tests {
+		p := gnpSimple
+		if v.details {
+			p = gnp
+		}
+		a := p.ParseName(v.name1).Authorship
+		b := p.ParseName(v.name2).Authorship
+		res := parsed.CompareAuthorship(a, b)
+		assert.Equal(t, res.Grade, v.grade, v.msg+": "+res.Explanation)
+	}
+}
+
+func TestCompareAuthorshipExplanation(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	a := gnp.ParseName("Aus bus (L.) Mill.").Authorship
+	b := gnp.ParseName("Aus bus (Linnaeus) Miller, 1768").Authorship
+	res := parsed.CompareAuthorship(a, b)
+	assert.Equal(t, res.Explanation,
+		`original authors are compatible ("L." matches "Linnaeus"); `+
+			`combination authors are compatible ("Mill." matches "Miller", `+
+			`year is missing in one authorship)`)
+}
+
+func TestJSONMatchGrade(t *testing.T) {
+	enc := gnfmt.GNjson{}
+	am := parsed.AuthMatch{Grade: parsed.PartialMatch, Explanation: "test"}
+	res, err := enc.Encode(am)
+	assert.Nil(t, err)
+	assert.Equal(t, string(res), `{"grade":"PARTIAL","explanation":"test"}`)
+	var am2 parsed.AuthMatch
+	err = enc.Decode(res, &am2)
+	assert.Nil(t, err)
+	assert.Equal(t, am2, am)
+}
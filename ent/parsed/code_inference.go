@@ -0,0 +1,185 @@
+package parsed
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/nomcode"
+)
+
+// CodeInference is a nomenclatural code inferred from features of a
+// name-string, together with the evidence used for the inference.
+type CodeInference struct {
+	// Code is the inferred nomenclatural code. It is empty, if the evidence
+	// does not point to one code.
+	Code nomcode.Code `json:"code"`
+
+	// Evidence contains features of the name-string that were used to
+	// infer the code.
+	Evidence []CodeEvidence `json:"evidence"`
+}
+
+// CodeEvidence is a feature of a name-string that suggests its
+// nomenclatural code.
+type CodeEvidence int
+
+const (
+	// NoEvidence is absence of evidence.
+	NoEvidence CodeEvidence = iota
+	// BotanicalRankEvidence is a rank used mostly in botany (var., f.,
+	// notho- ranks, sect. etc.).
+	BotanicalRankEvidence
+	// BacterialRankEvidence is a rank used in bacteriology (pathovar).
+	BacterialRankEvidence
+	// CombinationAuthorsEvidence is an authorship of a new combination,
+	// as in `(L.) Mill.`
+	CombinationAuthorsEvidence
+	// ExAuthorsEvidence is an authorship with `ex` authors.
+	ExAuthorsEvidence
+	// EmendAuthorsEvidence is an authorship with `emend.` authors.
+	EmendAuthorsEvidence
+	// GenusAuthorEvidence is a known ICN author of a genus in parentheses
+	// after the genus.
+	GenusAuthorEvidence
+	// YearEvidence is a year of publication in an authorship.
+	YearEvidence
+	// SubgenusEvidence is a subgenus in parentheses.
+	SubgenusEvidence
+	// HybridEvidence is a named hybrid or a hybrid formula.
+	HybridEvidence
+	// CultivarEvidence is a cultivar epithet.
+	CultivarEvidence
+	// GraftChimeraEvidence is a graft-chimera.
+	GraftChimeraEvidence
+	// CandidatusEvidence is a `Candidatus` prefix of a bacterial name.
+	CandidatusEvidence
+	// BacterialGenusEvidence is a genus that is registered only as
+	// bacterial.
+	BacterialGenusEvidence
+	// BacterialHomonymEvidence is a genus that is registered as bacterial,
+	// but has homonyms in other groups.
+	BacterialHomonymEvidence
+	// VirusEvidence is a name-string that belongs to viruses or other
+	// sub-cellular entities.
+	VirusEvidence
+)
+
+var evidenceMap = map[CodeEvidence]string{
+	NoEvidence:                 "",
+	BotanicalRankEvidence:      "BOTANICAL_RANK",
+	BacterialRankEvidence:      "BACTERIAL_RANK",
+	CombinationAuthorsEvidence: "COMBINATION_AUTHORS",
+	ExAuthorsEvidence:          "EX_AUTHORS",
+	EmendAuthorsEvidence:       "EMEND_AUTHORS",
+	GenusAuthorEvidence:        "GENUS_AUTHOR",
+	YearEvidence:               "YEAR",
+	SubgenusEvidence:           "SUBGENUS",
+	HybridEvidence:             "HYBRID",
+	CultivarEvidence:           "CULTIVAR",
+	GraftChimeraEvidence:       "GRAFT_CHIMERA",
+	CandidatusEvidence:         "CANDIDATUS",
+	BacterialGenusEvidence:     "BACTERIAL_GENUS",
+	BacterialHomonymEvidence:   "BACTERIAL_HOMONYM",
+	VirusEvidence:              "VIRUS",
+}
+
+var evidenceStrMap = func() map[string]CodeEvidence {
+	res := make(map[string]CodeEvidence)
+	for k, v := range evidenceMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// EvidenceWeightMap shows how strongly every kind of evidence points to
+// nomenclatural codes.
+var EvidenceWeightMap = map[CodeEvidence]map[nomcode.Code]int{
+	BotanicalRankEvidence: {nomcode.Botanical: 3},
+	BacterialRankEvidence: {nomcode.Bacterial: 3},
+	CombinationAuthorsEvidence: {
+		nomcode.Botanical: 2,
+		nomcode.Bacterial: 1,
+	},
+	ExAuthorsEvidence: {
+		nomcode.Botanical: 2,
+		nomcode.Bacterial: 1,
+	},
+	EmendAuthorsEvidence: {
+		nomcode.Botanical: 1,
+		nomcode.Bacterial: 1,
+	},
+	GenusAuthorEvidence: {nomcode.Botanical: 2},
+	YearEvidence: {
+		nomcode.Zoological: 2,
+		nomcode.Bacterial:  1,
+	},
+	SubgenusEvidence:         {nomcode.Zoological: 2},
+	HybridEvidence:           {nomcode.Botanical: 2},
+	CultivarEvidence:         {nomcode.Cultivars: 5},
+	GraftChimeraEvidence:     {nomcode.Cultivars: 3},
+	CandidatusEvidence:       {nomcode.Bacterial: 5},
+	BacterialGenusEvidence:   {nomcode.Bacterial: 3},
+	BacterialHomonymEvidence: {nomcode.Bacterial: 1},
+	VirusEvidence:            {nomcode.Virus: 5},
+}
+
+// NewCodeInference takes evidence found in a name-string and infers
+// a nomenclatural code from it. A code with the largest sum of weights
+// of the evidence wins. If several codes have the same largest sum, the
+// code is not inferred. If there is no evidence, it returns nil.
+func NewCodeInference(evidence []CodeEvidence) *CodeInference {
+	if len(evidence) == 0 {
+		return nil
+	}
+	ev := make([]CodeEvidence, len(evidence))
+	copy(ev, evidence)
+	sort.Slice(ev, func(i, j int) bool {
+		return ev[i] < ev[j]
+	})
+
+	scores := make(map[nomcode.Code]int)
+	for _, v := range ev {
+		for k, w := range EvidenceWeightMap[v] {
+			scores[k] += w
+		}
+	}
+
+	var code nomcode.Code
+	var max int
+	var tie bool
+	for k, v := range scores {
+		switch {
+		case v > max:
+			code, max, tie = k, v, false
+		case v == max:
+			tie = true
+		}
+	}
+	if tie {
+		code = nomcode.Unknown
+	}
+	return &CodeInference{Code: code, Evidence: ev}
+}
+
+// String is an implementation of fmt.Stringer interface.
+func (ce CodeEvidence) String() string {
+	return evidenceMap[ce]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (ce CodeEvidence) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + ce.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (ce *CodeEvidence) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*ce, ok = evidenceStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode CodeEvidence")
+	}
+	return err
+}
@@ -0,0 +1,53 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCodeInference(t *testing.T) {
+	tests := []struct {
+		msg  string
+		ev   []parsed.CodeEvidence
+		code nomcode.Code
+	}{
+		{"botany", []parsed.CodeEvidence{parsed.BotanicalRankEvidence},
+			nomcode.Botanical},
+		{"zoology", []parsed.CodeEvidence{
+			parsed.SubgenusEvidence, parsed.YearEvidence},
+			nomcode.Zoological},
+		{"tie", []parsed.CodeEvidence{
+			parsed.CombinationAuthorsEvidence, parsed.YearEvidence},
+			nomcode.Unknown},
+		{"bacteria", []parsed.CodeEvidence{
+			parsed.YearEvidence, parsed.BacterialHomonymEvidence,
+			parsed.CombinationAuthorsEvidence},
+			nomcode.Bacterial},
+	}
+	for _, v := range tests {
+		res := parsed.NewCodeInference(v.ev)
+		assert.Equal(t, res.Code, v.code, v.msg)
+		assert.Equal(t, len(res.Evidence), len(v.ev), v.msg)
+	}
+	assert.Nil(t, parsed.NewCodeInference(nil))
+}
+
+func TestJSONCodeInference(t *testing.T) {
+	ci := parsed.NewCodeInference([]parsed.CodeEvidence{
+		parsed.YearEvidence, parsed.SubgenusEvidence,
+	})
+	enc := gnfmt.GNjson{}
+	res, err := enc.Encode(ci)
+	assert.Nil(t, err)
+	assert.Equal(t, string(res),
+		`{"code":"ICZN","evidence":["YEAR","SUBGENUS"]}`)
+
+	var ci2 parsed.CodeInference
+	err = enc.Decode(res, &ci2)
+	assert.Nil(t, err)
+	assert.Equal(t, ci2, *ci)
+}
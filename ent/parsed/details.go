@@ -1,5 +1,7 @@
 package parsed
 
+import "github.com/gnames/gnparser/ent/nomcode"
+
 // Uninomial are details for names with cardinality 1.
 type Uninomial struct {
 	// Value is the uninomial name.
@@ -7,6 +9,14 @@ type Uninomial struct {
 	// Rank of the uninomial in a combination name, for example
 	// "Pereskia subg. Maihuenia Philippi ex F.A.C.Weber, 1898"
 	Rank string `json:"rank,omitempty"`
+	// RankNormalized is the rank of the uninomial in a controlled
+	// vocabulary, for example SUBGENUS for "subg.". For a single uninomial
+	// the rank is inferred from a suffix mandated by a nomenclatural code,
+	// for example FAMILY for "Rosaceae".
+	RankNormalized Rank `json:"rankNormalized,omitempty"`
+	// RankCode is the nomenclatural code that mandates the suffix used
+	// to infer the RankNormalized.
+	RankCode nomcode.Code `json:"rankCode,omitempty"`
 	// Cultivar is a value of a cultivar of a uninomial.
 	Cultivar string `json:"cultivar,omitempty"`
 	// Parent of a uninomial in a combination name.
@@ -44,10 +54,29 @@ type InfraspeciesElem struct {
 	Value string `json:"value"`
 	// Rank of the infraspecific epithet.
 	Rank string `json:"rank,omitempty"`
+	// RankNormalized is the rank of the infraspecific epithet in
+	// a controlled vocabulary, for example SUBSPECIES for "ssp.". If the
+	// epithet has no rank marker, the rank is inferred.
+	RankNormalized Rank `json:"rankNormalized,omitempty"`
 	// Authorship of the infraspecific epithet.
 	Authorship *Authorship `json:"authorship,omitempty"`
 }
 
+// Virus are details for names of viruses.
+type Virus struct {
+	// Genus is a value of a genus of an ICTV binomial, or an ICTV genus.
+	Genus string `json:"genus,omitempty"`
+	// Species is a value of a specific epithet of an ICTV binomial.
+	Species string `json:"species,omitempty"`
+	// Name is the name of a virus without strain and acronym, for example
+	// "Tobacco mosaic virus".
+	Name string `json:"name"`
+	// Strain is a strain or isolate designation of a virus.
+	Strain string `json:"strain,omitempty"`
+	// Acronym is an acronym of a virus, for example "TMV".
+	Acronym string `json:"acronym,omitempty"`
+}
+
 // Comparison are details for a surrogate comparison name.
 type Comparison struct {
 	// Genus is the genus of a name.
@@ -139,3 +168,12 @@ type DetailsApproximation struct {
 
 // isDetails implements Details interface.
 func (DetailsApproximation) isDetails() {}
+
+// DetailsVirus are details for virus names.
+type DetailsVirus struct {
+	// Virus details.
+	Virus Virus `json:"virus"`
+}
+
+// isDetails implements Details interface.
+func (DetailsVirus) isDetails() {}
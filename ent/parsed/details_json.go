@@ -0,0 +1,103 @@
+package parsed
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON implements json.Unmarshaler. Details of a name are an
+// interface, and its concrete type is recognized by the only key of the
+// "details" object (for example "species" or "hybridFormula").
+func (p *Parsed) UnmarshalJSON(bs []byte) error {
+	type parsedAlias Parsed
+	aux := struct {
+		*parsedAlias
+		Details json.RawMessage `json:"details,omitempty"`
+	}{parsedAlias: (*parsedAlias)(p)}
+
+	err := json.Unmarshal(bs, &aux)
+	if err != nil {
+		return err
+	}
+	p.Details, err = decodeDetails(aux.Details)
+	return err
+}
+
+// decodeDetails creates a concrete Details type from its JSON
+// representation. Elements of hybrid and graft-chimera formulas are
+// decoded recursively.
+func decodeDetails(bs json.RawMessage) (Details, error) {
+	if len(bs) == 0 || string(bs) == "null" {
+		return nil, nil
+	}
+
+	var raw map[string]json.RawMessage
+	err := json.Unmarshal(bs, &raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != 1 {
+		return nil, fmt.Errorf("cannot decode Details with %d keys", len(raw))
+	}
+
+	var res Details
+	for k, v := range raw {
+		switch k {
+		case "uninomial":
+			var d DetailsUninomial
+			err = json.Unmarshal(v, &d.Uninomial)
+			res = d
+		case "species":
+			var d DetailsSpecies
+			err = json.Unmarshal(v, &d.Species)
+			res = d
+		case "infraspecies":
+			var d DetailsInfraspecies
+			err = json.Unmarshal(v, &d.Infraspecies)
+			res = d
+		case "comparison":
+			var d DetailsComparison
+			err = json.Unmarshal(v, &d.Comparison)
+			res = d
+		case "approximation":
+			var d DetailsApproximation
+			err = json.Unmarshal(v, &d.Approximation)
+			res = d
+		case "virus":
+			var d DetailsVirus
+			err = json.Unmarshal(v, &d.Virus)
+			res = d
+		case "hybridFormula":
+			var d DetailsHybridFormula
+			d.HybridFormula, err = decodeDetailsSlice(v)
+			res = d
+		case "graftChimeraFormula":
+			var d DetailsGraftChimeraFormula
+			d.GraftChimeraFormula, err = decodeDetailsSlice(v)
+			res = d
+		default:
+			err = fmt.Errorf("cannot decode Details of type %q", k)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// decodeDetailsSlice decodes elements of a hybrid or graft-chimera formula.
+func decodeDetailsSlice(bs json.RawMessage) ([]Details, error) {
+	var raws []json.RawMessage
+	err := json.Unmarshal(bs, &raws)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Details, len(raws))
+	for i, v := range raws {
+		res[i], err = decodeDetails(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
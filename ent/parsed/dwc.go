@@ -0,0 +1,149 @@
+package parsed
+
+import (
+	"strings"
+
+	"github.com/gnames/gnfmt"
+)
+
+// DwC is an output format that uses Darwin Core terms. It is a CSV output
+// where elements of a name are distributed into atomized fields. The format
+// is not provided by gnfmt, so its value is set far from the values of
+// gnfmt formats.
+const DwC gnfmt.Format = 100
+
+// dwcHeader contains Darwin Core terms used in DwC output.
+var dwcHeader = []string{
+	"id", "scientificName", "scientificNameAuthorship", "genericName",
+	"infragenericEpithet", "specificEpithet", "infraspecificEpithet",
+	"taxonRank", "verbatimTaxonRank", "namePublishedInYear", "cultivarEpithet",
+}
+
+// dwc contains values of Darwin Core terms of a parsed name.
+type dwc struct {
+	genus, subgenus, species, infraspecies string
+	rank, verbatimRank, cultivar           string
+}
+
+func (p Parsed) dwcOutput() string {
+	var d dwc
+	var authorship, year string
+	if p.Parsed {
+		d = dwcDetails(p.Details)
+	}
+	if d.verbatimRank != "" {
+		d.verbatimRank = p.lastRankVerbatim(d.verbatimRank)
+	}
+	if p.Authorship != nil {
+		authorship = p.Authorship.Normalized
+		year = dwcYear(p.Authorship)
+	}
+
+	res := []string{
+		p.VerbatimID,
+		p.Normalized,
+		authorship,
+		d.genus,
+		d.subgenus,
+		d.species,
+		d.infraspecies,
+		d.rank,
+		d.verbatimRank,
+		year,
+		strings.Trim(d.cultivar, "‘’'"),
+	}
+	return gnfmt.ToCSV(res, ',')
+}
+
+// dwcDetails distributes Details of a name into atomized fields. Hybrid
+// formulas and viruses do not have atomized fields.
+func dwcDetails(d Details) dwc {
+	var res dwc
+	switch dt := d.(type) {
+	case DetailsUninomial:
+		u := dt.Uninomial
+		res.cultivar = u.Cultivar
+		res.verbatimRank = u.Rank
+		res.rank = dwcRank(u.RankNormalized)
+		if u.Parent != "" && isGenusDivision(u.RankNormalized) {
+			res.genus = u.Parent
+			res.subgenus = u.Value
+		}
+	case DetailsSpecies:
+		res = dwcSpecies(dt.Species)
+	case DetailsInfraspecies:
+		res = dwcSpecies(dt.Infraspecies.Species)
+		isp := dt.Infraspecies.Infraspecies
+		if len(isp) == 0 {
+			break
+		}
+		last := isp[len(isp)-1]
+		res.infraspecies = last.Value
+		res.verbatimRank = last.Rank
+		res.rank = dwcRank(last.RankNormalized)
+		if res.rank == "" {
+			res.rank = dwcRank(InfraspecificRank)
+		}
+	case DetailsComparison:
+		res.genus = dt.Comparison.Genus
+		res.species = dt.Comparison.Species
+		res.cultivar = dt.Comparison.Cultivar
+		if res.species != "" {
+			res.rank = dwcRank(SpeciesRank)
+		}
+	case DetailsApproximation:
+		res.genus = dt.Approximation.Genus
+		res.species = dt.Approximation.Species
+		res.cultivar = dt.Approximation.Cultivar
+		if res.species != "" {
+			res.rank = dwcRank(SpeciesRank)
+		}
+	}
+	return res
+}
+
+// isGenusDivision checks if a rank is between genus and species
+// (subgenus, section, series etc.). Only uninomials of such ranks have
+// a genus as a parent.
+func isGenusDivision(r Rank) bool {
+	return r > GenusRank && r < SpeciesRank
+}
+
+func dwcSpecies(sp Species) dwc {
+	return dwc{
+		genus:    sp.Genus,
+		subgenus: sp.Subgenus,
+		species:  sp.Species,
+		rank:     dwcRank(SpeciesRank),
+		cultivar: sp.Cultivar,
+	}
+}
+
+// dwcRank converts a rank of a name to a term of GBIF rank vocabulary.
+// It returns an empty string for unknown ranks.
+func dwcRank(r Rank) string {
+	return strings.ToLower(strings.ReplaceAll(r.String(), "_", ""))
+}
+
+// lastRankVerbatim returns the last rank of a name as it was given in the
+// name-string. If the name has no words, the given rank is returned.
+func (p Parsed) lastRankVerbatim(rank string) string {
+	for i := len(p.Words) - 1; i >= 0; i-- {
+		if p.Words[i].Type == RankType {
+			return p.Words[i].Verbatim
+		}
+	}
+	return rank
+}
+
+// dwcYear returns the year of publication of a name. For new combinations
+// it is the year of the combination, not of the original description.
+func dwcYear(au *Authorship) string {
+	if au.Combination != nil {
+		if au.Combination.Year == nil {
+			return ""
+		}
+		return au.Combination.Year.Value
+	}
+	return strings.Trim(au.Year, "()")
+}
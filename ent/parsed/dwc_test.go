@@ -0,0 +1,59 @@
+package parsed_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDwC(t *testing.T) {
+	cfg := gnparser.NewConfig(
+		gnparser.OptFormat("dwc"),
+		gnparser.OptWithCultivars(true),
+	)
+	gnp := gnparser.New(cfg)
+	assert.Equal(t, gnp.Format(), parsed.DwC)
+	assert.Equal(t, parsed.HeaderCSV(parsed.DwC),
+		"id,scientificName,scientificNameAuthorship,genericName,"+
+			"infragenericEpithet,specificEpithet,infraspecificEpithet,"+
+			"taxonRank,verbatimTaxonRank,namePublishedInYear,cultivarEpithet")
+
+	tests := []struct {
+		msg, name, res string
+	}{
+		{"uninomial", "Homo L.", "Homo L.,L.,,,,,,,,"},
+		{"family", "Rosaceae Juss.", "Rosaceae Juss.,Juss.,,,,,family,,,"},
+		{"subgenus", "Pereskia subg. Maihuenia Phil.",
+			"Pereskia subgen. Maihuenia Phil.,Phil.,Pereskia,Maihuenia,,," +
+				"subgenus,subg.,,"},
+		{"tribe", "Asteraceae trib. Heliantheae",
+			"Asteraceae trib. Heliantheae,,,,,,tribe,trib.,,"},
+		{"species", "Aus (Bus) cus Linnaeus, 1758",
+			"Aus (Bus) cus Linnaeus 1758,Linnaeus 1758,Aus,Bus,cus,,species,," +
+				"1758,"},
+		{"combination", "Aus bus (L.) Mill. 1768",
+			"Aus bus (L.) Mill. 1768,(L.) Mill. 1768,Aus,,bus,,species,,1768,"},
+		{"infrasp", "Aus bus ssp. cus Mill.",
+			"Aus bus subsp. cus Mill.,Mill.,Aus,,bus,cus,subspecies,ssp.,,"},
+		{"no rank", "Aus bus cus",
+			"Aus bus cus,,Aus,,bus,cus,infraspecificname,,,"},
+		{"zoo no rank", "Aus bus cus Smith, 1900",
+			"Aus bus cus Smith 1900,Smith 1900,Aus,,bus,cus,subspecies,,1900,"},
+		{"cultivar", "Sarracenia flava 'Maxima'",
+			"Sarracenia flava ‘Maxima’,,Sarracenia,,flava,,species,,,Maxima"},
+		{"comparison", "Aus cf. bus", "Aus cf. bus,,Aus,,bus,,species,,,"},
+		{"hybrid", "Aus bus × Cus dus", "Aus bus × Cus dus,,,,,,,,,"},
+		{"not parsed", "Not a name", ",,,,,,,,,"},
+	}
+
+	for _, v := range tests {
+		p := gnp.ParseName(v.name)
+		res := p.Output(gnp.Format())
+		assert.True(t, strings.HasPrefix(res, p.VerbatimID+","), v.msg)
+		res = strings.TrimPrefix(res, p.VerbatimID+",")
+		assert.Equal(t, res, v.res, v.msg)
+	}
+}
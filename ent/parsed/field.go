@@ -0,0 +1,329 @@
+package parsed
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Field is a column of CSV or TSV output. Fields allow to flatten Details,
+// Words and QualityWarnings of a parsed name into columns.
+type Field int
+
+const (
+	// NoField is an unknown field.
+	NoField Field = iota
+	// IDField is a UUID v5 of a name-string.
+	IDField
+	// VerbatimField is the name-string as given.
+	VerbatimField
+	// NormalizedField is the normalized name.
+	NormalizedField
+	// CardinalityField is the number of elements of a canonical form.
+	CardinalityField
+	// CanonicalStemField is the stemmed canonical form.
+	CanonicalStemField
+	// CanonicalSimpleField is the simple canonical form.
+	CanonicalSimpleField
+	// CanonicalFullField is the full canonical form.
+	CanonicalFullField
+	// AuthorshipField is the normalized authorship of a name.
+	AuthorshipField
+	// AuthorsField are authors of a name separated by '|'.
+	AuthorsField
+	// YearField is the year of a name.
+	YearField
+	// QualityField is the parsing quality.
+	QualityField
+	// WarningsField are quality warnings separated by '|'.
+	WarningsField
+	// UninomialField is a uninomial name, or an infrageneric epithet of
+	// a uninomial that is given together with its genus.
+	UninomialField
+	// GenusField is the genus of a name.
+	GenusField
+	// SubgenusField is the subgenus of a name.
+	SubgenusField
+	// SpeciesField is the specific epithet of a name.
+	SpeciesField
+	// InfraspeciesField are infraspecific epithets separated by '|'.
+	InfraspeciesField
+	// RankField are ranks of infraspecific epithets (or of a uninomial)
+	// separated by '|'.
+	RankField
+	// CultivarField is the cultivar epithet of a name.
+	CultivarField
+	// HybridField is the type of a hybrid.
+	HybridField
+	// SurrogateField is the type of a surrogate name.
+	SurrogateField
+	// TailField is the unparsed tail of a name-string.
+	TailField
+	// WordsField are verbatim words of a name separated by '|'.
+	WordsField
+	// WordTypesField are types of the words separated by '|'.
+	WordTypesField
+	// RankNormalizedField is the normalized rank of a name.
+	RankNormalizedField
+)
+
+// DefaultFields are the columns of CSV and TSV outputs used if no fields
+// are given.
+var DefaultFields = []Field{
+	IDField, VerbatimField, CardinalityField, CanonicalStemField,
+	CanonicalSimpleField, CanonicalFullField, AuthorshipField, YearField,
+	QualityField,
+}
+
+var fieldMap = map[Field]string{
+	NoField:              "",
+	IDField:              "id",
+	VerbatimField:        "verbatim",
+	NormalizedField:      "normalized",
+	CardinalityField:     "cardinality",
+	CanonicalStemField:   "stemmed",
+	CanonicalSimpleField: "simple",
+	CanonicalFullField:   "full",
+	AuthorshipField:      "authorship",
+	AuthorsField:         "authors",
+	YearField:            "year",
+	QualityField:         "quality",
+	WarningsField:        "warnings",
+	UninomialField:       "uninomial",
+	GenusField:           "genus",
+	SubgenusField:        "subgenus",
+	SpeciesField:         "species",
+	InfraspeciesField:    "infraspecies",
+	RankField:            "rank",
+	CultivarField:        "cultivar",
+	HybridField:          "hybrid",
+	SurrogateField:       "surrogate",
+	TailField:            "tail",
+	WordsField:           "words",
+	WordTypesField:       "word_types",
+	RankNormalizedField:  "rank_normalized",
+}
+
+var fieldStrMap = func() map[string]Field {
+	res := make(map[string]Field)
+	for k, v := range fieldMap {
+		res[v] = k
+	}
+	return res
+}()
+
+var fieldHeaderMap = map[Field]string{
+	IDField:              "Id",
+	VerbatimField:        "Verbatim",
+	NormalizedField:      "Normalized",
+	CardinalityField:     "Cardinality",
+	CanonicalStemField:   "CanonicalStem",
+	CanonicalSimpleField: "CanonicalSimple",
+	CanonicalFullField:   "CanonicalFull",
+	AuthorshipField:      "Authorship",
+	AuthorsField:         "Authors",
+	YearField:            "Year",
+	QualityField:         "Quality",
+	WarningsField:        "Warnings",
+	UninomialField:       "Uninomial",
+	GenusField:           "Genus",
+	SubgenusField:        "Subgenus",
+	SpeciesField:         "Species",
+	InfraspeciesField:    "Infraspecies",
+	RankField:            "Rank",
+	CultivarField:        "Cultivar",
+	HybridField:          "Hybrid",
+	SurrogateField:       "Surrogate",
+	TailField:            "Tail",
+	WordsField:           "Words",
+	WordTypesField:       "WordTypes",
+	RankNormalizedField:  "RankNormalized",
+}
+
+// String is an implementation of fmt.Stringer interface.
+func (f Field) String() string {
+	return fieldMap[f]
+}
+
+// Header returns the name of the field's column in CSV and TSV outputs.
+func (f Field) Header() string {
+	return fieldHeaderMap[f]
+}
+
+// NewFields converts a comma-separated list of field names (for example
+// "id,genus,species") to fields. It returns an error if some of the names
+// are unknown.
+func NewFields(s string) ([]Field, error) {
+	var res []Field
+	var unknown []string
+	for _, v := range strings.Split(s, ",") {
+		v = strings.ToLower(strings.TrimSpace(v))
+		if v == "" {
+			continue
+		}
+		if f, ok := fieldStrMap[v]; ok {
+			res = append(res, f)
+		} else {
+			unknown = append(unknown, v)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown fields: %s", strings.Join(unknown, ", "))
+	}
+	return res, nil
+}
+
+// FieldsNeedDetails returns true if some of the fields are taken from
+// Details or Words of a parsed name.
+func FieldsNeedDetails(fs []Field) bool {
+	for _, v := range fs {
+		switch v {
+		case UninomialField, GenusField, SubgenusField, SpeciesField,
+			InfraspeciesField, RankField, CultivarField, WordsField,
+			WordTypesField:
+			return true
+		}
+	}
+	return false
+}
+
+// flatDetails contains Details of a name flattened into strings.
+type flatDetails struct {
+	uninomial, genus, subgenus, species, infraspecies, rank, cultivar string
+}
+
+func newFlatDetails(d Details) flatDetails {
+	var res flatDetails
+	switch dt := d.(type) {
+	case DetailsUninomial:
+		u := dt.Uninomial
+		res.uninomial = u.Value
+		if isGenusDivision(u.RankNormalized) {
+			res.genus = u.Parent
+		}
+		res.rank = u.Rank
+		res.cultivar = u.Cultivar
+	case DetailsSpecies:
+		res.setSpecies(dt.Species)
+	case DetailsInfraspecies:
+		res.setSpecies(dt.Infraspecies.Species)
+		isp := dt.Infraspecies.Infraspecies
+		epithets := make([]string, len(isp))
+		ranks := make([]string, len(isp))
+		for i, v := range isp {
+			epithets[i] = v.Value
+			ranks[i] = v.Rank
+		}
+		res.infraspecies = strings.Join(epithets, "|")
+		res.rank = strings.Join(ranks, "|")
+	case DetailsComparison:
+		res.genus = dt.Comparison.Genus
+		res.species = dt.Comparison.Species
+		res.cultivar = dt.Comparison.Cultivar
+	case DetailsApproximation:
+		res.genus = dt.Approximation.Genus
+		res.species = dt.Approximation.Species
+		res.cultivar = dt.Approximation.Cultivar
+	}
+	return res
+}
+
+func (fd *flatDetails) setSpecies(sp Species) {
+	fd.genus = sp.Genus
+	fd.subgenus = sp.Subgenus
+	fd.species = sp.Species
+	fd.cultivar = sp.Cultivar
+}
+
+// fieldValues returns values of the given fields of a parsed name.
+func (p Parsed) fieldValues(fs []Field) []string {
+	var fd flatDetails
+	if FieldsNeedDetails(fs) {
+		fd = newFlatDetails(p.Details)
+	}
+
+	res := make([]string, len(fs))
+	for i, v := range fs {
+		switch v {
+		case IDField:
+			res[i] = p.VerbatimID
+		case VerbatimField:
+			res[i] = p.Verbatim
+		case NormalizedField:
+			res[i] = p.Normalized
+		case CardinalityField:
+			res[i] = strconv.Itoa(p.Cardinality)
+		case CanonicalStemField:
+			if p.Canonical != nil {
+				res[i] = p.Canonical.Stemmed
+			}
+		case CanonicalSimpleField:
+			if p.Canonical != nil {
+				res[i] = p.Canonical.Simple
+			}
+		case CanonicalFullField:
+			if p.Canonical != nil {
+				res[i] = p.Canonical.Full
+			}
+		case AuthorshipField:
+			if p.Authorship != nil {
+				res[i] = p.Authorship.Normalized
+			}
+		case AuthorsField:
+			if p.Authorship != nil {
+				res[i] = strings.Join(p.Authorship.Authors, "|")
+			}
+		case YearField:
+			if p.Authorship != nil {
+				res[i] = p.Authorship.Year
+			}
+		case QualityField:
+			res[i] = strconv.Itoa(p.ParseQuality)
+		case WarningsField:
+			ws := make([]string, len(p.QualityWarnings))
+			for j := range p.QualityWarnings {
+				ws[j] = p.QualityWarnings[j].Warning.String()
+			}
+			res[i] = strings.Join(ws, "|")
+		case UninomialField:
+			res[i] = fd.uninomial
+		case GenusField:
+			res[i] = fd.genus
+		case SubgenusField:
+			res[i] = fd.subgenus
+		case SpeciesField:
+			res[i] = fd.species
+		case InfraspeciesField:
+			res[i] = fd.infraspecies
+		case RankField:
+			res[i] = fd.rank
+		case CultivarField:
+			res[i] = fd.cultivar
+		case HybridField:
+			if p.Hybrid != nil {
+				res[i] = p.Hybrid.String()
+			}
+		case SurrogateField:
+			if p.Surrogate != nil {
+				res[i] = p.Surrogate.String()
+			}
+		case TailField:
+			res[i] = p.Tail
+		case WordsField:
+			ws := make([]string, len(p.Words))
+			for j := range p.Words {
+				ws[j] = p.Words[j].Verbatim
+			}
+			res[i] = strings.Join(ws, "|")
+		case WordTypesField:
+			ws := make([]string, len(p.Words))
+			for j := range p.Words {
+				ws[j] = p.Words[j].Type.String()
+			}
+			res[i] = strings.Join(ws, "|")
+		case RankNormalizedField:
+			res[i] = p.Rank.String()
+		}
+	}
+	return res
+}
@@ -0,0 +1,82 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFields(t *testing.T) {
+	tests := []struct {
+		msg, s string
+		res    []parsed.Field
+		err    bool
+	}{
+		{"empty", "", nil, false},
+		{"one", "genus", []parsed.Field{parsed.GenusField}, false},
+		{"several", "id, Species,word_types",
+			[]parsed.Field{parsed.IDField, parsed.SpeciesField,
+				parsed.WordTypesField}, false},
+		{"unknown", "genus,gen", nil, true},
+	}
+
+	for _, v := range tests {
+		res, err := parsed.NewFields(v.s)
+		assert.Equal(t, err != nil, v.err, v.msg)
+		assert.Equal(t, res, v.res, v.msg)
+	}
+}
+
+func TestOutputFields(t *testing.T) {
+	fields, err := parsed.NewFields(
+		"genus,subgenus,species,infraspecies,rank,rank_normalized,cultivar," +
+			"authors,year,warnings,tail,hybrid,surrogate",
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, parsed.HeaderCSV(gnfmt.CSV, fields...),
+		"Genus,Subgenus,Species,Infraspecies,Rank,RankNormalized,Cultivar,"+
+			"Authors,Year,Warnings,Tail,Hybrid,Surrogate")
+	assert.Equal(t, parsed.HeaderCSV(gnfmt.CompactJSON, fields...), "")
+
+	cfg := gnparser.NewConfig(
+		gnparser.OptFields(fields),
+		gnparser.OptWithCultivars(true),
+	)
+	gnp := gnparser.New(cfg)
+	tests := []struct {
+		msg, name, res string
+	}{
+		{"uninomial", "Pereskia subg. Maihuenia Phil.",
+			"Pereskia,,,,subgen.,SUBGENUS,,Phil.,," +
+				"Combination of two uninomials,,,"},
+		{"tribe", "Asteraceae trib. Heliantheae",
+			",,,,trib.,TRIBE,,,,Combination of two uninomials,,,"},
+		{"species", "Aus (Bus) cus Linn. & Mill., 1758",
+			"Aus,Bus,cus,,,SPECIES,,Linn.|Mill.,1758,,,,"},
+		{"infrasp", "Aus bus var. cus f. dus",
+			"Aus,,bus,cus|dus,var.|f.,FORM,,,,,,,"},
+		{"cultivar", "Sarracenia flava 'Maxima'",
+			"Sarracenia,,flava,,,SPECIES,‘Maxima’,,,,,,"},
+		{"tail", "Aus bus @",
+			`Aus,,bus,,,SPECIES,,,,Unparsed tail," @",,`},
+		{"hybrid", "Aus bus × Cus dus",
+			",,,,,,,,,Hybrid formula,,HYBRID_FORMULA,"},
+		{"surrogate", "Aus cf. bus",
+			"Aus,,bus,,,SPECIES,,,,Name comparison,,,COMPARISON"},
+		{"not parsed", "Not a name", ",,,,,,,,,,,,"},
+	}
+
+	for _, v := range tests {
+		p := gnp.ParseName(v.name)
+		assert.Equal(t, p.Output(gnp.Format(), gnp.Fields()...), v.res, v.msg)
+	}
+
+	p := gnp.ParseName("Homo sapiens L.")
+	res := p.Output(gnfmt.TSV, parsed.WordsField, parsed.WordTypesField)
+	assert.Equal(t, res, "Homo|sapiens|L.\tGENUS|SPECIES|AUTHOR_WORD")
+	res = p.Output(gnfmt.CSV)
+	assert.Equal(t, res, p.Output(gnfmt.CSV, parsed.DefaultFields...))
+}
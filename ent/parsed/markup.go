@@ -0,0 +1,183 @@
+package parsed
+
+import (
+	"html"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/nomcode"
+)
+
+// HTML and Markdown are output formats for publishing. They return
+// name-strings where genera, epithets and other Latin parts of names are
+// italicized, while ranks, authors, hybrid signs and comparison markers
+// stay upright. Like DwC, these formats are not provided by gnfmt.
+const (
+	// HTML wraps italic parts of a name in <i> tags, and escapes HTML
+	// special characters.
+	HTML gnfmt.Format = 101
+	// Markdown wraps italic parts of a name in '*', and escapes Markdown
+	// special characters.
+	Markdown gnfmt.Format = 102
+)
+
+// span is a part of a string from start to end byte.
+type span struct {
+	start, end int
+}
+
+// markupOutput returns the verbatim name-string with italicized Latin
+// parts. Positions of words are known only if the name was parsed with
+// details, otherwise the name-string is only escaped. If positions of the
+// words do not match the verbatim name-string (for example HTML tags were
+// removed from it), the normalized name is used.
+func (p Parsed) markupOutput(f gnfmt.Format) string {
+	openTag, closeTag, escape := "<i>", "</i>", html.EscapeString
+	if f == Markdown {
+		openTag, closeTag, escape = "*", "*", escapeMarkdown
+	}
+
+	s := p.Verbatim
+	spans, ok := p.verbatimSpans()
+	if !ok {
+		s = p.Normalized
+		spans = p.normalizedSpans()
+	}
+
+	var res strings.Builder
+	var pos int
+	for _, v := range spans {
+		res.WriteString(escape(s[pos:v.start]))
+		res.WriteString(openTag + escape(s[v.start:v.end]) + closeTag)
+		pos = v.end
+	}
+	res.WriteString(escape(s[pos:]))
+	return res.String()
+}
+
+// verbatimSpans returns italic spans of the verbatim name-string. It
+// returns false if positions of words do not match the name-string.
+func (p Parsed) verbatimSpans() ([]span, bool) {
+	// positions of words are given in runes.
+	idx := make([]int, 0, len(p.Verbatim)+1)
+	for i := range p.Verbatim {
+		idx = append(idx, i)
+	}
+	idx = append(idx, len(p.Verbatim))
+
+	var res []span
+	var pos int
+	for _, v := range p.Words {
+		if v.Start < pos || v.End < v.Start || v.End >= len(idx) {
+			return nil, false
+		}
+		sp := span{start: idx[v.Start], end: idx[v.End]}
+		if p.Verbatim[sp.start:sp.end] != v.Verbatim {
+			return nil, false
+		}
+		pos = v.End
+		if p.isItalic(v) {
+			res = addSpan(p.Verbatim, res, sp)
+		}
+	}
+	return res, true
+}
+
+// normalizedSpans returns italic spans of the normalized name. Words are
+// searched in the normalized name one after another.
+func (p Parsed) normalizedSpans() []span {
+	var res []span
+	var pos int
+	for _, v := range p.Words {
+		i := strings.Index(p.Normalized[pos:], v.Normalized)
+		if v.Normalized == "" || i == -1 {
+			continue
+		}
+		sp := span{start: pos + i, end: pos + i + len(v.Normalized)}
+		pos = sp.end
+		if p.isItalic(v) {
+			res = addSpan(p.Normalized, res, sp)
+		}
+	}
+	return res
+}
+
+// addSpan adds a span to the spans. Italic words separated only by spaces
+// are joined into one span.
+func addSpan(s string, spans []span, sp span) []span {
+	if l := len(spans); l > 0 &&
+		strings.TrimSpace(s[spans[l-1].end:sp.start]) == "" {
+		spans[l-1].end = sp.end
+		return spans
+	}
+	return append(spans, sp)
+}
+
+// isItalic decides if a word of the name is italicized. Names of all ranks
+// are italic, except zoological names above genus. If the rank of
+// a zoological uninomial is not known, family-group suffixes are used to
+// find names above genus. In "Candidatus" names only the word "Candidatus"
+// is italic. Cultivar epithets, ranks, authors, hybrid signs, comparison
+// and approximation markers are upright.
+func (p Parsed) isItalic(w Word) bool {
+	if p.isCandidatus() {
+		return w.Type == CandidatusType
+	}
+	switch w.Type {
+	case GenusType, SubgenusType, SpEpithetType, InfraspEpithetType,
+		SuperspType:
+		return true
+	case UninomialType:
+		if p.markupCode() != nomcode.Zoological {
+			return true
+		}
+		if p.Rank == NoRank {
+			return !hasZooFamilySuffix(w.Normalized)
+		}
+		return p.Rank >= GenusRank
+	}
+	return false
+}
+
+func (p Parsed) isCandidatus() bool {
+	for _, v := range p.Words {
+		if v.Type == CandidatusType {
+			return true
+		}
+	}
+	return false
+}
+
+// markupCode returns the code given to the parser, or the inferred code.
+func (p Parsed) markupCode() nomcode.Code {
+	if p.Code == nomcode.Unknown && p.InferredCode != nil {
+		return p.InferredCode.Code
+	}
+	return p.Code
+}
+
+// zooFamilySuffixes are endings of family-group names mandated by
+// the zoological code (ICZN Art. 29.2), except "-ina" of subtribes that is
+// a common ending of genera.
+var zooFamilySuffixes = []string{"oidea", "idae", "inae", "ini"}
+
+// hasZooFamilySuffix returns true if a uninomial ends with a family-group
+// suffix of the zoological code.
+func hasZooFamilySuffix(uninomial string) bool {
+	for _, v := range zooFamilySuffixes {
+		if len(uninomial) > len(v)+1 && strings.HasSuffix(uninomial, v) {
+			return true
+		}
+	}
+	return false
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "#", `\#`,
+)
+
+// escapeMarkdown escapes characters that have special meaning in Markdown.
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,74 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkupOutput(t *testing.T) {
+	tests := []struct {
+		msg, name    string
+		code         nomcode.Code
+		html, markdn string
+	}{
+		{"infrasp", "Aus bus (L.) Mill. var. cus Smith", nomcode.Unknown,
+			"<i>Aus bus</i> (L.) Mill. var. <i>cus</i> Smith",
+			"*Aus bus* (L.) Mill. var. *cus* Smith"},
+		{"subgenus", "Aus (Bus) cus L.", nomcode.Unknown,
+			"<i>Aus</i> (<i>Bus</i>) <i>cus</i> L.",
+			"*Aus* (*Bus*) *cus* L."},
+		{"comparison", "Aus cf. bus", nomcode.Unknown,
+			"<i>Aus</i> cf. <i>bus</i>", "*Aus* cf. *bus*"},
+		{"approximation", "Aus sp.", nomcode.Unknown,
+			"<i>Aus</i> sp.", "*Aus* sp."},
+		{"hybrid formula", "Aus bus × Cus dus", nomcode.Unknown,
+			"<i>Aus bus</i> × <i>Cus dus</i>", "*Aus bus* × *Cus dus*"},
+		{"named hybrid", "×Agropogon littoralis", nomcode.Unknown,
+			"×<i>Agropogon littoralis</i>", "×*Agropogon littoralis*"},
+		{"cultivar", "Rosa 'Peace'", nomcode.Cultivars,
+			"<i>Rosa</i> &#39;Peace&#39;", "*Rosa* 'Peace'"},
+		{"bot family", "Rosaceae Juss.", nomcode.Unknown,
+			"<i>Rosaceae</i> Juss.", "*Rosaceae* Juss."},
+		{"zoo family", "Felidae Gray, 1821", nomcode.Unknown,
+			"Felidae Gray, 1821", "Felidae Gray, 1821"},
+		{"zoo family code", "Felidae", nomcode.Zoological,
+			"Felidae", "Felidae"},
+		{"zoo superfamily short stem", "Apoidea", nomcode.Zoological,
+			"Apoidea", "Apoidea"},
+		{"bot short stem", "Ascoidea", nomcode.Botanical,
+			"<i>Ascoidea</i>", "*Ascoidea*"},
+		{"unknown family", "Felidae", nomcode.Unknown,
+			"<i>Felidae</i>", "*Felidae*"},
+		{"zoo genus", "Felis Linnaeus, 1758", nomcode.Zoological,
+			"<i>Felis</i> Linnaeus, 1758", "*Felis* Linnaeus, 1758"},
+		{"candidatus", "Candidatus Liberibacter asiaticus", nomcode.Unknown,
+			"<i>Candidatus</i> Liberibacter asiaticus",
+			"*Candidatus* Liberibacter asiaticus"},
+		{"legacy virus", "Tobacco mosaic virus", nomcode.Unknown,
+			"Tobacco mosaic virus", "Tobacco mosaic virus"},
+		{"nom status", "Aus bus L. nom. nud.", nomcode.Unknown,
+			"<i>Aus bus</i> L. nom. nud.", "*Aus bus* L. nom. nud."},
+		{"escape", "Aus bus_cus [sic] <b>", nomcode.Unknown,
+			"<i>Aus</i> bus_cus [sic] &lt;b&gt;",
+			`*Aus* bus\_cus \[sic\] \<b\>`},
+		{"html tags", "<i>Aus   bus</i> L. & Smith", nomcode.Unknown,
+			"<i>Aus bus</i> L. &amp; Smith", "*Aus bus* L. & Smith"},
+		{"not parsed", "<b>not a name</b>", nomcode.Unknown,
+			"&lt;b&gt;not a name&lt;/b&gt;", `\<b\>not a name\</b\>`},
+	}
+
+	for _, v := range tests {
+		cfg := gnparser.NewConfig(
+			gnparser.OptCode(v.code),
+			gnparser.OptFormat("html"),
+		)
+		gnp := gnparser.New(cfg)
+		p := gnp.ParseName(v.name)
+		assert.Equal(t, p.Output(parsed.HTML), v.html, v.msg)
+		assert.Equal(t, p.Output(parsed.Markdown), v.markdn, v.msg)
+	}
+}
@@ -0,0 +1,63 @@
+package parsed
+
+import (
+	"errors"
+	"strings"
+)
+
+// MatchGrade tells how well two elements of scientific names match each
+// other. Larger values mean better matches.
+type MatchGrade int
+
+const (
+	// UnknownMatch means that elements cannot be compared, for example
+	// because one of them is missing.
+	UnknownMatch MatchGrade = iota
+	// ConflictingMatch means that elements contradict each other.
+	ConflictingMatch
+	// PartialMatch means that only some parts of the elements match.
+	PartialMatch
+	// CompatibleMatch means that elements are written differently, but
+	// most likely mean the same, for example "L." and "Linnaeus".
+	CompatibleMatch
+	// IdenticalMatch means that elements are the same.
+	IdenticalMatch
+)
+
+var matchGradeMap = map[MatchGrade]string{
+	UnknownMatch:     "UNKNOWN",
+	ConflictingMatch: "CONFLICTING",
+	PartialMatch:     "PARTIAL",
+	CompatibleMatch:  "COMPATIBLE",
+	IdenticalMatch:   "IDENTICAL",
+}
+
+var matchGradeStrMap = func() map[string]MatchGrade {
+	res := make(map[string]MatchGrade)
+	for k, v := range matchGradeMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// String is an implementation of fmt.Stringer interface.
+func (mg MatchGrade) String() string {
+	return matchGradeMap[mg]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (mg MatchGrade) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + mg.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (mg *MatchGrade) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*mg, ok = matchGradeStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode MatchGrade")
+	}
+	return err
+}
@@ -0,0 +1,140 @@
+package parsed
+
+import (
+	"errors"
+	"strings"
+)
+
+// NameMatch is a result of comparison of two parsed names.
+type NameMatch struct {
+	// Level is the strongest level at which the names are the same.
+	Level MatchLevel `json:"level"`
+	// Authorship is the result of comparison of authorships of the names.
+	Authorship AuthMatch `json:"authorship"`
+	// CardinalityMismatch is true if the names have different number of
+	// elements, for example a species and a subspecies.
+	CardinalityMismatch bool `json:"cardinalityMismatch,omitempty"`
+	// RankMismatch is true if the names have different infraspecific ranks,
+	// for example "var." and "subsp.".
+	RankMismatch bool `json:"rankMismatch,omitempty"`
+}
+
+// MatchLevel is a level at which two names are the same.
+type MatchLevel int
+
+const (
+	// NoneLevel means that names are different.
+	NoneLevel MatchLevel = iota
+	// GenusLevel means that only genera of the names are the same.
+	GenusLevel
+	// StemmedLevel means that stemmed canonical forms are the same.
+	StemmedLevel
+	// SimpleLevel means that simple canonical forms are the same.
+	SimpleLevel
+	// FullLevel means that full canonical forms are the same.
+	FullLevel
+	// NormalizedLevel means that normalized names are the same.
+	NormalizedLevel
+	// VerbatimLevel means that name-strings are the same.
+	VerbatimLevel
+)
+
+var matchLevelMap = map[MatchLevel]string{
+	NoneLevel:       "NONE",
+	GenusLevel:      "GENUS",
+	StemmedLevel:    "STEMMED",
+	SimpleLevel:     "SIMPLE",
+	FullLevel:       "FULL",
+	NormalizedLevel: "NORMALIZED",
+	VerbatimLevel:   "VERBATIM",
+}
+
+var matchLevelStrMap = func() map[string]MatchLevel {
+	res := make(map[string]MatchLevel)
+	for k, v := range matchLevelMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// String is an implementation of fmt.Stringer interface.
+func (ml MatchLevel) String() string {
+	return matchLevelMap[ml]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (ml MatchLevel) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + ml.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (ml *MatchLevel) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*ml, ok = matchLevelStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode MatchLevel")
+	}
+	return err
+}
+
+// Compare compares two parsed names. It finds the strongest level at which
+// the names are the same, compares their authorships, cardinalities and
+// ranks. Authorships are compared by CompareAuthorship, so names parsed
+// with details give better results.
+func Compare(a, b Parsed) NameMatch {
+	res := NameMatch{
+		Level:      matchLevel(a, b),
+		Authorship: CompareAuthorship(a.Authorship, b.Authorship),
+	}
+	if !a.Parsed || !b.Parsed {
+		return res
+	}
+	res.CardinalityMismatch = a.Cardinality != b.Cardinality
+	ranksA, ranksB := rankWords(a), rankWords(b)
+	res.RankMismatch = len(ranksA) > 0 && len(ranksB) > 0 &&
+		strings.Join(ranksA, " ") != strings.Join(ranksB, " ")
+	return res
+}
+
+func matchLevel(a, b Parsed) MatchLevel {
+	switch {
+	case a.Verbatim == b.Verbatim:
+		return VerbatimLevel
+	case !a.Parsed || !b.Parsed:
+		return NoneLevel
+	case a.Normalized == b.Normalized:
+		return NormalizedLevel
+	case a.Canonical.Full == b.Canonical.Full:
+		return FullLevel
+	case a.Canonical.Simple == b.Canonical.Simple:
+		return SimpleLevel
+	case a.Canonical.Stemmed == b.Canonical.Stemmed:
+		return StemmedLevel
+	}
+	genA := strings.SplitN(a.Canonical.Simple, " ", 2)[0]
+	genB := strings.SplitN(b.Canonical.Simple, " ", 2)[0]
+	if genA == genB {
+		return GenusLevel
+	}
+	return NoneLevel
+}
+
+// rankWords returns ranks of a name. They are the words of the full
+// canonical form that are missing in the simple canonical form, except
+// hybrid signs.
+func rankWords(p Parsed) []string {
+	simple := make(map[string]struct{})
+	for _, v := range strings.Fields(p.Canonical.Simple) {
+		simple[v] = struct{}{}
+	}
+	var res []string
+	for _, v := range strings.Fields(p.Canonical.Full) {
+		if _, ok := simple[v]; ok || v == "×" {
+			continue
+		}
+		res = append(res, v)
+	}
+	return res
+}
@@ -0,0 +1,67 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	tests := []struct {
+		msg, name1, name2 string
+		level             parsed.MatchLevel
+		card, rank        bool
+	}{
+		{"verbatim", "Aus bus L.", "Aus bus L.", parsed.VerbatimLevel,
+			false, false},
+		{"normalized", "Aus  bus L.", "Aus bus L.", parsed.NormalizedLevel,
+			false, false},
+		{"full", "Aus bus var. cus L.", "Aus bus var. cus Mill.",
+			parsed.FullLevel, false, false},
+		{"simple", "Aus bus var. cus", "Aus bus subsp. cus",
+			parsed.SimpleLevel, false, true},
+		{"stemmed", "Aus albus", "Aus alba", parsed.StemmedLevel, false, false},
+		{"genus", "Aus bus", "Aus bus cus", parsed.GenusLevel, true, false},
+		{"none", "Aus bus", "Cus bus", parsed.NoneLevel, false, false},
+		{"not parsed", "Aus bus", "", parsed.NoneLevel, false, false},
+	}
+
+	for _, v := range tests {
+		res := parsed.Compare(gnp.ParseName(v.name1), gnp.ParseName(v.name2))
+		assert.Equal(t, res.Level, v.level, v.msg)
+		assert.Equal(t, res.CardinalityMismatch, v.card, v.msg)
+		assert.Equal(t, res.RankMismatch, v.rank, v.msg)
+	}
+}
+
+func TestCompareAuthorshipLevel(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	res := parsed.Compare(
+		gnp.ParseName("Aus bus (L.) Mill."),
+		gnp.ParseName("Aus bus (Linnaeus) Miller, 1768"),
+	)
+	assert.Equal(t, res.Level, parsed.FullLevel)
+	assert.Equal(t, res.Authorship.Grade, parsed.CompatibleMatch)
+}
+
+func TestJSONMatchLevel(t *testing.T) {
+	enc := gnfmt.GNjson{}
+	nm := parsed.NameMatch{
+		Level:        parsed.GenusLevel,
+		Authorship:   parsed.AuthMatch{Grade: parsed.UnknownMatch},
+		RankMismatch: true,
+	}
+	res, err := enc.Encode(nm)
+	assert.Nil(t, err)
+	assert.Equal(t, string(res),
+		`{"level":"GENUS","authorship":{"grade":"UNKNOWN","explanation":""},`+
+			`"rankMismatch":true}`)
+	var nm2 parsed.NameMatch
+	err = enc.Decode(res, &nm2)
+	assert.Nil(t, err)
+	assert.Equal(t, nm2, nm)
+}
@@ -0,0 +1,119 @@
+package parsed
+
+import (
+	"errors"
+	"strings"
+)
+
+// NomenclaturalStatus is a nomenclatural status annotation that follows
+// a name, for example "nom. nud." or "comb. nov.".
+type NomenclaturalStatus struct {
+	// Verbatim is the status as it was found in the name-string.
+	Verbatim string `json:"verbatim"`
+	// Status is a normalized value of the status from a controlled
+	// vocabulary.
+	Status NomStatus `json:"status"`
+}
+
+// NomStatus is a controlled vocabulary of nomenclatural statuses.
+type NomStatus int
+
+const (
+	// NoNomStatus means absence of a status.
+	NoNomStatus NomStatus = iota
+	// NomenNudumStatus is a name published without a description (nom. nud.).
+	NomenNudumStatus
+	// NomenIllegitimumStatus is an illegitimate name (nom. illeg.).
+	NomenIllegitimumStatus
+	// NomenInvalidumStatus is a name that was not validly published
+	// (nom. inval.).
+	NomenInvalidumStatus
+	// NomenConservandumStatus is a conserved name (nom. cons.).
+	NomenConservandumStatus
+	// NomenRejiciendumStatus is a rejected name (nom. rej.).
+	NomenRejiciendumStatus
+	// NomenDubiumStatus is a name of uncertain application (nom. dub.).
+	NomenDubiumStatus
+	// NomenOblitumStatus is a forgotten name (nom. obl.).
+	NomenOblitumStatus
+	// NomenProtectumStatus is a protected name (nom. prot.).
+	NomenProtectumStatus
+	// NomenSuperfluumStatus is a superfluous name (nom. superfl.).
+	NomenSuperfluumStatus
+	// NomenNovumStatus is a replacement name (nom. nov.).
+	NomenNovumStatus
+	// NomenAmbiguumStatus is an ambiguous name (nom. ambig.).
+	NomenAmbiguumStatus
+	// NomenProvisoriumStatus is a provisional name (nom. prov.).
+	NomenProvisoriumStatus
+	// CombinatioNovaStatus is a new combination (comb. nov.).
+	CombinatioNovaStatus
+	// StatusNovusStatus is a name with a new rank (stat. nov.).
+	StatusNovusStatus
+	// GenusNovumStatus is a new genus (gen. nov.).
+	GenusNovumStatus
+	// SpeciesNovaStatus is a new species (sp. nov.).
+	SpeciesNovaStatus
+	// SubspeciesNovaStatus is a new subspecies (subsp. nov.).
+	SubspeciesNovaStatus
+	// VarietasNovaStatus is a new variety (var. nov.).
+	VarietasNovaStatus
+	// IneditusStatus is an unpublished name (ined.).
+	IneditusStatus
+	// OrthographicVariantStatus is an orthographic variant (orth. var.).
+	OrthographicVariantStatus
+)
+
+var nomStatusMap = map[NomStatus]string{
+	NoNomStatus:               "",
+	NomenNudumStatus:          "NOMEN_NUDUM",
+	NomenIllegitimumStatus:    "NOMEN_ILLEGITIMUM",
+	NomenInvalidumStatus:      "NOMEN_INVALIDUM",
+	NomenConservandumStatus:   "NOMEN_CONSERVANDUM",
+	NomenRejiciendumStatus:    "NOMEN_REJICIENDUM",
+	NomenDubiumStatus:         "NOMEN_DUBIUM",
+	NomenOblitumStatus:        "NOMEN_OBLITUM",
+	NomenProtectumStatus:      "NOMEN_PROTECTUM",
+	NomenSuperfluumStatus:     "NOMEN_SUPERFLUUM",
+	NomenNovumStatus:          "NOMEN_NOVUM",
+	NomenAmbiguumStatus:       "NOMEN_AMBIGUUM",
+	NomenProvisoriumStatus:    "NOMEN_PROVISORIUM",
+	CombinatioNovaStatus:      "COMBINATIO_NOVA",
+	StatusNovusStatus:         "STATUS_NOVUS",
+	GenusNovumStatus:          "GENUS_NOVUM",
+	SpeciesNovaStatus:         "SPECIES_NOVA",
+	SubspeciesNovaStatus:      "SUBSPECIES_NOVA",
+	VarietasNovaStatus:        "VARIETAS_NOVA",
+	IneditusStatus:            "INEDITUS",
+	OrthographicVariantStatus: "ORTHOGRAPHIC_VARIANT",
+}
+
+var nomStatusStrMap = func() map[string]NomStatus {
+	res := make(map[string]NomStatus)
+	for k, v := range nomStatusMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// String is an implementation of fmt.Stringer interface.
+func (ns NomStatus) String() string {
+	return nomStatusMap[ns]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (ns NomStatus) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + ns.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (ns *NomStatus) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*ns, ok = nomStatusStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode NomStatus")
+	}
+	return err
+}
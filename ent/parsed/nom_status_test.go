@@ -0,0 +1,30 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONNomStatus(t *testing.T) {
+	enc := gnfmt.GNjson{}
+	ns := []parsed.NomenclaturalStatus{
+		{Verbatim: "nom. nud.", Status: parsed.NomenNudumStatus},
+		{Verbatim: "comb. nov.", Status: parsed.CombinatioNovaStatus},
+	}
+	res, err := enc.Encode(ns)
+	assert.Nil(t, err)
+	assert.Equal(t, string(res),
+		`[{"verbatim":"nom. nud.","status":"NOMEN_NUDUM"},`+
+			`{"verbatim":"comb. nov.","status":"COMBINATIO_NOVA"}]`)
+	var ns2 []parsed.NomenclaturalStatus
+	err = enc.Decode(res, &ns2)
+	assert.Nil(t, err)
+	assert.Equal(t, ns2, ns)
+
+	var st parsed.NomStatus
+	err = st.UnmarshalJSON([]byte(`"NOMEN_NOTHING"`))
+	assert.NotNil(t, err)
+}
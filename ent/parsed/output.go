@@ -1,67 +1,59 @@
 package parsed
 
 import (
-	"strconv"
-
 	"github.com/gnames/gnfmt"
 	gncsv "github.com/gnames/gnfmt"
 )
 
-// Output creates a JSON or CSV representation of Parsed results.
-func (p Parsed) Output(f gnfmt.Format) string {
+// Output creates a JSON, CSV, Darwin Core, HTML or Markdown representation
+// of Parsed results. Fields set columns of CSV and TSV outputs, if no fields are
+// given, DefaultFields are used. Other formats ignore fields.
+func (p Parsed) Output(f gnfmt.Format, fields ...Field) string {
 	switch f {
 	case gnfmt.CSV:
-		return p.csvOutput(',')
+		return p.csvOutput(',', fields)
+	case DwC:
+		return p.dwcOutput()
 	case gnfmt.TSV:
-		return p.csvOutput('\t')
+		return p.csvOutput('\t', fields)
 	case gnfmt.CompactJSON:
 		return p.jsonOutput(false)
 	case gnfmt.PrettyJSON:
 		return p.jsonOutput(true)
+	case HTML, Markdown:
+		return p.markupOutput(f)
 	default:
 		return "N/A"
 	}
 }
 
-// HeadersCSV returns the CSV header for parsing output.
-func HeaderCSV(f gnfmt.Format) string {
-	header := []string{"Id", "Verbatim", "Cardinality", "CanonicalStem",
-		"CanonicalSimple", "CanonicalFull", "Authorship", "Year", "Quality"}
+// HeadersCSV returns the CSV header for parsing output. Fields set columns
+// of CSV and TSV outputs, if no fields are given, DefaultFields are used.
+func HeaderCSV(f gnfmt.Format, fields ...Field) string {
+	if len(fields) == 0 {
+		fields = DefaultFields
+	}
+	header := make([]string, len(fields))
+	for i, v := range fields {
+		header[i] = v.Header()
+	}
 	switch f {
 	case gnfmt.CSV:
 		return gnfmt.ToCSV(header, ',')
 	case gnfmt.TSV:
 		return gnfmt.ToCSV(header, '\t')
+	case DwC:
+		return gnfmt.ToCSV(dwcHeader, ',')
 	default:
 		return ""
 	}
 }
 
-func (p Parsed) csvOutput(sep rune) string {
-	var stem, simple, full, authorship, year string
-	if p.Canonical != nil {
-		stem = p.Canonical.Stemmed
-		simple = p.Canonical.Simple
-		full = p.Canonical.Full
-	}
-
-	if p.Authorship != nil {
-		authorship = p.Authorship.Normalized
-		year = p.Authorship.Year
-	}
-
-	res := []string{
-		p.VerbatimID,
-		p.Verbatim,
-		strconv.Itoa(p.Cardinality),
-		stem,
-		simple,
-		full,
-		authorship,
-		year,
-		strconv.Itoa(p.ParseQuality),
+func (p Parsed) csvOutput(sep rune, fields []Field) string {
+	if len(fields) == 0 {
+		fields = DefaultFields
 	}
-	return gncsv.ToCSV(res, sep)
+	return gncsv.ToCSV(p.fieldValues(fields), sep)
 }
 
 func (p Parsed) jsonOutput(pretty bool) string {
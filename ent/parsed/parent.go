@@ -0,0 +1,16 @@
+package parsed
+
+// Parent is a name of a higher rank that is implied by a parsed name. For
+// example "Agalinis purpurea (L.) Briton var. borealis (Berg.) Peterson 1987"
+// implies genus "Agalinis" and species "Agalinis purpurea (L.) Briton".
+type Parent struct {
+	// Rank is the normalized rank of the parent.
+	Rank Rank `json:"rank,omitempty"`
+	// Normalized is the normalized parent name, together with authorships
+	// that are given for its elements in the name-string.
+	Normalized string `json:"normalized"`
+	// Canonical are canonical forms of the parent name.
+	Canonical *Canonical `json:"canonical"`
+	// Authorship of the parent, if it is given in the name-string.
+	Authorship *Authorship `json:"authorship,omitempty"`
+}
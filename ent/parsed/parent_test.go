@@ -0,0 +1,100 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParents(t *testing.T) {
+	type parent struct {
+		rank             parsed.Rank
+		normalized, full string
+	}
+	tests := []struct {
+		msg, name string
+		code      nomcode.Code
+		parents   []parent
+	}{
+		{"uninomial", "Aus L.", nomcode.Unknown, nil},
+		{"binomial", "Aus bus L.", nomcode.Unknown, []parent{
+			{parsed.GenusRank, "Aus", "Aus"},
+		}},
+		{"trinomial",
+			"Agalinis purpurea (L.) Briton var. borealis (Berg.) Peterson 1987",
+			nomcode.Unknown, []parent{
+				{parsed.GenusRank, "Agalinis", "Agalinis"},
+				{parsed.SpeciesRank, "Agalinis purpurea (L.) Briton",
+					"Agalinis purpurea"},
+			}},
+		{"subgenus binomial", "Aus (Bus) cus Smith", nomcode.Unknown,
+			[]parent{
+				{parsed.GenusRank, "Aus", "Aus"},
+				{parsed.SubgenusRank, "Aus (Bus)", "Aus subgen. Bus"},
+			}},
+		{"quadrinomial", "Aus (Bus) cus L. var. dus Mill. f. eus",
+			nomcode.Unknown, []parent{
+				{parsed.GenusRank, "Aus", "Aus"},
+				{parsed.SubgenusRank, "Aus (Bus)", "Aus subgen. Bus"},
+				{parsed.SpeciesRank, "Aus (Bus) cus L.", "Aus cus"},
+				{parsed.VarietyRank, "Aus (Bus) cus L. var. dus Mill.",
+					"Aus cus var. dus"},
+			}},
+		{"unranked quadrinomial", "Aus bus cus dus", nomcode.Botanical,
+			[]parent{
+				{parsed.GenusRank, "Aus", "Aus"},
+				{parsed.SpeciesRank, "Aus bus", "Aus bus"},
+				{parsed.InfraspecificRank, "Aus bus cus", "Aus bus cus"},
+			}},
+		{"subgenus", "Pereskia subg. Maihuenia Phil.", nomcode.Unknown,
+			[]parent{{parsed.GenusRank, "Pereskia", "Pereskia"}}},
+		{"cultivar", "Aus bus 'Cult'", nomcode.Cultivars, []parent{
+			{parsed.GenusRank, "Aus", "Aus"},
+			{parsed.SpeciesRank, "Aus bus", "Aus bus"},
+		}},
+		{"named genus hybrid", "×Agropogon littoralis var. bus",
+			nomcode.Unknown, []parent{
+				{parsed.GenusRank, "× Agropogon", "× Agropogon"},
+				{parsed.SpeciesRank, "× Agropogon littoralis",
+					"× Agropogon littoralis"},
+			}},
+		{"named species hybrid", "Aus × bus var. cus", nomcode.Unknown,
+			[]parent{
+				{parsed.GenusRank, "Aus", "Aus"},
+				{parsed.SpeciesRank, "Aus × bus", "Aus × bus"},
+			}},
+		{"candidatus", "Candidatus Aus bus", nomcode.Unknown, []parent{
+			{parsed.GenusRank, "Candidatus Aus", "Candidatus Aus"},
+		}},
+		{"hybrid formula", "Aus bus var. cus × Dus eus", nomcode.Unknown, nil},
+		{"approximation", "Aus sp.", nomcode.Unknown, nil},
+	}
+
+	for _, v := range tests {
+		cfg := gnparser.NewConfig(
+			gnparser.OptCode(v.code),
+			gnparser.OptWithDetails(true),
+		)
+		gnp := gnparser.New(cfg)
+		p := gnp.ParseName(v.name)
+		var res []parent
+		for _, pr := range p.Parents {
+			res = append(res, parent{pr.Rank, pr.Normalized, pr.Canonical.Full})
+		}
+		assert.Equal(t, res, v.parents, v.msg)
+	}
+
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	p := gnp.ParseName("Aus bus L. var. cus Mill.")
+	sp := p.Parents[1]
+	assert.Equal(t, sp.Canonical.Stemmed, "Aus bus")
+	assert.Equal(t, sp.Authorship.Normalized, "L.")
+	assert.Nil(t, p.Parents[0].Authorship)
+
+	gnp = gnparser.New(gnparser.NewConfig())
+	p = gnp.ParseName("Aus bus L. var. cus Mill.")
+	assert.Nil(t, p.Parents)
+}
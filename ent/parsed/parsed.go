@@ -3,6 +3,7 @@
 package parsed
 
 import (
+	"github.com/gnames/gnparser/ent/nomcode"
 	tb "github.com/gnames/tribool"
 )
 
@@ -50,21 +51,52 @@ type Parsed struct {
 	// 4 - quadrinomial
 	Cardinality int `json:"cardinality"`
 
+	// Rank is the normalized rank of the most fine-grained element of a
+	// name. It is taken from a rank marker, or inferred from the name
+	// (SPECIES for binomials, SUBSPECIES for zoological trinomials without
+	// a rank marker, INFRASPECIFIC_NAME for other trinomials without
+	// a rank marker).
+	Rank Rank `json:"rankNormalized,omitempty"`
+
 	// Authorship describes provided metainformation about authors of a name.
 	// This authorship provided outside of Details belongs to
 	// the most fine-grained element of a name.
 	Authorship *Authorship `json:"authorship,omitempty"`
 
+	// Code is the nomenclatural code that was given to the parser. It is
+	// used to resolve ambiguities, for example if `f.` means 'filius' or
+	// 'forma'. It is empty if the code was not set.
+	Code nomcode.Code `json:"code,omitempty"`
+
+	// InferredCode is a nomenclatural code inferred from features of the
+	// name-string, such as ranks, authorship, or cultivar epithets. It also
+	// contains the evidence used for the inference. It is nil if no evidence
+	// was found.
+	InferredCode *CodeInference `json:"inferredCode,omitempty"`
+
 	// Bacteria is not nil if the input name has a genus
 	// that is registered as bacterial. Possible
 	// values are "maybe" - if the genus has homonyms in other groups
 	// and "yes" if GNparser dictionary does not detect any homonyms
 	//
-	// The bacterial names often contain strain information which are
-	// not parseable and are placed into the "tail" field.
+	// The bacterial names often contain strain information. Recognized
+	// strain information is placed into the "strain" field, the rest
+	// goes to the "tail" field.
 	Bacteria *tb.Tribool `json:"bacteria,omitempty"`
 
-	// Virus is set to true in case if name is not parsed, and probably
+	// Strain contains strain designation, serovar, pathovar, biovar and
+	// culture collection accessions of a bacterial name.
+	Strain *Strain `json:"strain,omitempty"`
+
+	// TaxonConcept contains taxon concept qualifiers that follow the name,
+	// like "sensu", "auct.", "s.l.", "s.str.", "p.p.".
+	TaxonConcept *TaxonConcept `json:"taxonConcept,omitempty"`
+
+	// NomenclaturalStatus contains nomenclatural status annotations that
+	// follow the name, like "nom. nud.", "comb. nov.", "sp. nov.".
+	NomenclaturalStatus []NomenclaturalStatus `json:"nomenclaturalStatus,omitempty"`
+
+	// Virus is set to true in case if name probably
 	// belongs to a wide variety of sub-cellular entities like
 	//
 	// - viruses
@@ -77,10 +109,16 @@ type Parsed struct {
 	// as a result they gave (very imprecise) name to
 	// the field.
 	//
-	// We do plan to create a parser for viruses at some point,
-	// which will expand this group into more precise categories.
+	// Names of viruses (ICTV binomials and genera, as well as legacy
+	// names like "Tobacco mosaic virus") are parsed, other names from
+	// this group are not parsed.
 	Virus bool `json:"virus,omitempty"`
 
+	// VirusCategory makes Virus field more precise. It tells if a name
+	// belongs to a virus, bacteriophage, satellite, viroid, plasmid, prion,
+	// vector, or a nucleic acid.
+	VirusCategory VirusCategory `json:"virusCategory,omitempty"`
+
 	// DaggerChar if true if a name-string includes '†' rune.
 	// This rune might mean a fossil, or be indication of the clade extinction.
 	DaggerChar bool `json:"daggerChar,omitempty"`
@@ -118,6 +156,11 @@ type Parsed struct {
 	// Details contain more fine-grained information about parsed name.
 	Details Details `json:"details,omitempty"`
 
+	// Parents are names of higher ranks implied by the name, from the
+	// highest to the lowest rank (for example genus and species of an
+	// infraspecific name). They are provided only if WithDetails is true.
+	Parents []Parent `json:"parents,omitempty"`
+
 	// Words contain description of every parsed word of a name.
 	Words []Word `json:"words,omitempty"`
 
@@ -173,6 +216,9 @@ type Authorship struct {
 	Year string `json:"year,omitempty"`
 	// Authors is a slice containing each author as an element.
 	Authors []string `json:"authors,omitempty"`
+	// AuthorDetails provided only if "with_details=true". It contains
+	// standard forms and keys of the Authors.
+	AuthorDetails []Author `json:"authorDetails,omitempty"`
 	// Original is an AuthGroup that contains authors of the original
 	// description of a name.
 	Original *AuthGroup `json:"originalAuth,omitempty"`
@@ -190,6 +236,8 @@ type Authorship struct {
 type AuthGroup struct {
 	// Authors is a slice of strings containing found outhors
 	Authors []string `json:"authors"`
+	// AuthorDetails contains standard forms and keys of the Authors.
+	AuthorDetails []Author `json:"authorDetails,omitempty"`
 	// Year provided only if "with_details=true" Year of the original
 	// publication. If a range of the years provided, the start year is kept,
 	// with isApproximate flag set to true.
@@ -208,13 +256,42 @@ type AuthGroup struct {
 type Authors struct {
 	// Authors is a slice of strings containing found outhors of an AuthGroup
 	Authors []string `json:"authors"`
+	// AuthorDetails contains standard forms and keys of the Authors.
+	AuthorDetails []Author `json:"authorDetails,omitempty"`
 	// Year of publication by the AuthGroup.
 	Year *Year `json:"year,omitempty"`
 }
 
+// Author contains a normalized name of an author together with its
+// standard form.
+type Author struct {
+	// Value is a normalized name of an author.
+	Value string `json:"value"`
+	// Initials of the author, for example "J. D." in "J. D. Hook.".
+	Initials string `json:"initials,omitempty"`
+	// Particles are lowercase prefixes of the surname, for example
+	// "van der" or "d'".
+	Particles string `json:"particles,omitempty"`
+	// Surname of the author, without initials and particles.
+	Surname string `json:"surname,omitempty"`
+	// Filius is true if the author is a "son of" another author with the
+	// same name, for example "Hook. f.".
+	Filius bool `json:"filius,omitempty"`
+	// Suffix that follows the surname, for example "Jr." or "bis".
+	Suffix string `json:"suffix,omitempty"`
+	// Standard is a standard abbreviation of the author's name (IPNI,
+	// Brummitt & Powell). If the author is not in the dictionary, it is
+	// the same as the Value.
+	Standard string `json:"standard"`
+	// Key is a stable key of an author. Different variants of an author's
+	// name, for example "L.", "Linn." and "Linnaeus", have the same key.
+	Key string `json:"key"`
+}
+
 // Year provided only if "with_details=true" Year of the original
 // publication. If a range of the years provided, the start year is kept,
-// with isApproximate flag set to true.
+// with isApproximate flag set to true. The whole range is given by Start
+// and End fields.
 type Year struct {
 	// Value is a string value of a year.
 	Value string `json:"year"`
@@ -222,4 +299,13 @@ type Year struct {
 	// Approximate year might be represented by a range of years, by
 	// a question mark "188?", by parentheses "(1888)".
 	IsApproximate bool `json:"isApproximate,omitempty"`
+	// Verbatim is the year as it was given in the name-string, for example
+	// "1880-1885", "[1888]" or "(1888)".
+	Verbatim string `json:"verbatim,omitempty"`
+	// Start is the earliest year the Value might mean. It is the first
+	// year of a range, or 1880 for "188?".
+	Start int `json:"start,omitempty"`
+	// End is the latest year the Value might mean. It is the last year of
+	// a range, or 1889 for "188?". For exact years it is the same as Start.
+	End int `json:"end,omitempty"`
 }
@@ -0,0 +1,249 @@
+package parsed
+
+import (
+	"errors"
+	"strings"
+)
+
+// Rank is a normalized taxonomic rank of a name or of its element. Ranks
+// are taken from rank markers of a name-string (for example "ssp.",
+// "subsp", "fma", "var"), or inferred from the structure of the name.
+type Rank int
+
+const (
+	// NoRank means the rank is not known.
+	NoRank Rank = iota
+	// PhylumRank is inferred from '-ota' suffix of bacterial names.
+	PhylumRank
+	// DivisionRank is used for 'div.' marker, and is inferred from
+	// '-phyta', '-mycota' suffixes.
+	DivisionRank
+	// SubdivisionRank is inferred from '-phytina', '-mycotina' suffixes.
+	SubdivisionRank
+	// ClassRank is inferred from '-opsida', '-phyceae', '-mycetes'
+	// suffixes.
+	ClassRank
+	// SubclassRank is inferred from '-idae' (botany), '-phycidae',
+	// '-mycetidae' suffixes.
+	SubclassRank
+	// SuperorderRank is inferred from '-anae' suffix.
+	SuperorderRank
+	// OrderRank is inferred from '-ales' suffix.
+	OrderRank
+	// SuborderRank is inferred from '-ineae' suffix.
+	SuborderRank
+	// SuperfamilyRank is inferred from '-oidea' suffix.
+	SuperfamilyRank
+	// FamilyRank is used for 'fam.' marker, and is inferred from '-aceae',
+	// '-idae' (zoology) suffixes.
+	FamilyRank
+	// SubfamilyRank is used for 'subfam.' marker, and is inferred from
+	// '-oideae', '-inae' (zoology) suffixes.
+	SubfamilyRank
+	// SupertribeRank is used for 'supertrib.' marker.
+	SupertribeRank
+	// TribeRank is used for 'trib.' marker, and is inferred from '-eae',
+	// '-ini' (zoology) suffixes.
+	TribeRank
+	// SubtribeRank is used for 'subtrib.' marker, and is inferred from
+	// '-inae' (botany) suffix.
+	SubtribeRank
+	// GenusRank is used for 'nothogen.' marker.
+	GenusRank
+	// SubgenusRank is used for 'subg.', 'subgen.' markers and for
+	// subgenera in parentheses.
+	SubgenusRank
+	// SectionRank is used for 'sect.' marker.
+	SectionRank
+	// SubsectionRank is used for 'subsect.' marker.
+	SubsectionRank
+	// SeriesRank is used for 'ser.' marker.
+	SeriesRank
+	// SubseriesRank is used for 'subser.' marker.
+	SubseriesRank
+	// SpeciesRank is used for binomial names, and for 'agamosp.',
+	// 'nothosp.' markers.
+	SpeciesRank
+	// SubspeciesRank is used for 'subsp.', 'ssp.' markers, and for
+	// infraspecific epithets of zoological trinomials without a marker.
+	SubspeciesRank
+	// VarietyRank is used for 'var.', 'nvar.' markers.
+	VarietyRank
+	// ConvarietyRank is used for 'convar.' marker.
+	ConvarietyRank
+	// SubvarietyRank is used for 'subvar.' marker.
+	SubvarietyRank
+	// FormRank is used for 'f.', 'fo.', 'fma', 'forma' markers.
+	FormRank
+	// SubformRank is used for 'subf.' marker.
+	SubformRank
+	// FormaSpecialisRank is used for 'f.sp.' marker.
+	FormaSpecialisRank
+	// MorphRank is used for 'morph.' marker.
+	MorphRank
+	// NatioRank is used for 'natio', 'nat.' markers.
+	NatioRank
+	// RaceRank is used for 'race' marker.
+	RaceRank
+	// AberrationRank is used for 'ab.' marker.
+	AberrationRank
+	// PathovarRank is used for 'pv.', 'pathovar' markers.
+	PathovarRank
+	// CultivarRank is used for 'cv.' marker.
+	CultivarRank
+	// InfraspecificRank is used for infraspecific epithets with unknown or
+	// uncommon markers (for example Greek letters), and for epithets without
+	// markers that cannot be inferred as subspecies.
+	InfraspecificRank
+)
+
+var rankMap = map[Rank]string{
+	NoRank:             "",
+	PhylumRank:         "PHYLUM",
+	DivisionRank:       "DIVISION",
+	SubdivisionRank:    "SUBDIVISION",
+	ClassRank:          "CLASS",
+	SubclassRank:       "SUBCLASS",
+	SuperorderRank:     "SUPERORDER",
+	OrderRank:          "ORDER",
+	SuborderRank:       "SUBORDER",
+	SuperfamilyRank:    "SUPERFAMILY",
+	FamilyRank:         "FAMILY",
+	SubfamilyRank:      "SUBFAMILY",
+	SupertribeRank:     "SUPERTRIBE",
+	TribeRank:          "TRIBE",
+	SubtribeRank:       "SUBTRIBE",
+	GenusRank:          "GENUS",
+	SubgenusRank:       "SUBGENUS",
+	SectionRank:        "SECTION",
+	SubsectionRank:     "SUBSECTION",
+	SeriesRank:         "SERIES",
+	SubseriesRank:      "SUBSERIES",
+	SpeciesRank:        "SPECIES",
+	SubspeciesRank:     "SUBSPECIES",
+	VarietyRank:        "VARIETY",
+	ConvarietyRank:     "CONVARIETY",
+	SubvarietyRank:     "SUBVARIETY",
+	FormRank:           "FORM",
+	SubformRank:        "SUBFORM",
+	FormaSpecialisRank: "FORMA_SPECIALIS",
+	MorphRank:          "MORPH",
+	NatioRank:          "NATIO",
+	RaceRank:           "RACE",
+	AberrationRank:     "ABERRATION",
+	PathovarRank:       "PATHOVAR",
+	CultivarRank:       "CULTIVAR",
+	InfraspecificRank:  "INFRASPECIFIC_NAME",
+}
+
+var rankStrMap = func() map[string]Rank {
+	res := make(map[string]Rank)
+	for k, v := range rankMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// rankMarkers maps rank markers (lowercase, without spaces and a trailing
+// period) to ranks.
+var rankMarkers = map[string]Rank{
+	"div":          DivisionRank,
+	"fam":          FamilyRank,
+	"subfam":       SubfamilyRank,
+	"supertrib":    SupertribeRank,
+	"trib":         TribeRank,
+	"subtrib":      SubtribeRank,
+	"nothosubtrib": SubtribeRank,
+	"nothogen":     GenusRank,
+	"subg":         SubgenusRank,
+	"subgen":       SubgenusRank,
+	"nothosubg":    SubgenusRank,
+	"nothosubgen":  SubgenusRank,
+	"nothosubgeen": SubgenusRank,
+	"sect":         SectionRank,
+	"nothosect":    SectionRank,
+	"subsect":      SubsectionRank,
+	"nothosubsect": SubsectionRank,
+	"ser":          SeriesRank,
+	"nothoser":     SeriesRank,
+	"subser":       SubseriesRank,
+	"agamosp":      SpeciesRank,
+	"nothosp":      SpeciesRank,
+	"subsp":        SubspeciesRank,
+	"ssp":          SubspeciesRank,
+	"subspec":      SubspeciesRank,
+	"agamossp":     SubspeciesRank,
+	"nothosubsp":   SubspeciesRank,
+	"nothossp":     SubspeciesRank,
+	"var":          VarietyRank,
+	"[var.]":       VarietyRank,
+	"variety":      VarietyRank,
+	"agamovar":     VarietyRank,
+	"nothovar":     VarietyRank,
+	"nvar":         VarietyRank,
+	"convar":       ConvarietyRank,
+	"subvar":       SubvarietyRank,
+	"f":            FormRank,
+	"fo":           FormRank,
+	"fm":           FormRank,
+	"fma":          FormRank,
+	"form":         FormRank,
+	"forma":        FormRank,
+	"nothof":       FormRank,
+	"nothofo":      FormRank,
+	"subf":         SubformRank,
+	"f.sp":         FormaSpecialisRank,
+	"morph":        MorphRank,
+	"nothomorth":   MorphRank,
+	"natio":        NatioRank,
+	"nat":          NatioRank,
+	"race":         RaceRank,
+	"ab":           AberrationRank,
+	"ab.n":         AberrationRank,
+	"pv":           PathovarRank,
+	"pathovar":     PathovarRank,
+	"cv":           CultivarRank,
+}
+
+// NewRank converts a rank marker, for example "ssp.", "subsp" or "fma", to
+// a Rank. Markers that are not known return InfraspecificRank, an empty
+// marker returns NoRank.
+func NewRank(marker string) Rank {
+	m := strings.ToLower(strings.TrimSpace(marker))
+	if m == "" {
+		return NoRank
+	}
+	// Greek letters can follow a rank marker, as in "var. β".
+	if fs := strings.Fields(m); len(fs) > 1 {
+		if r, ok := rankMarkers[strings.TrimSuffix(fs[0], ".")]; ok {
+			return r
+		}
+		m = strings.Join(fs, "")
+	}
+	if r, ok := rankMarkers[strings.TrimSuffix(m, ".")]; ok {
+		return r
+	}
+	return InfraspecificRank
+}
+
+// String is an implementation of fmt.Stringer interface.
+func (r Rank) String() string {
+	return rankMap[r]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r Rank) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + r.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (r *Rank) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*r, ok = rankStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode Rank")
+	}
+	return err
+}
@@ -0,0 +1,212 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRank(t *testing.T) {
+	tests := []struct {
+		marker string
+		rank   parsed.Rank
+	}{
+		{"", parsed.NoRank},
+		{"ssp.", parsed.SubspeciesRank},
+		{"subsp", parsed.SubspeciesRank},
+		{"fma", parsed.FormRank},
+		{"f.", parsed.FormRank},
+		{"var", parsed.VarietyRank},
+		{"[var.]", parsed.VarietyRank},
+		{"var. β", parsed.VarietyRank},
+		{"nothovar.", parsed.VarietyRank},
+		{"convar.", parsed.ConvarietyRank},
+		{"subgen.", parsed.SubgenusRank},
+		{"notho sect.", parsed.SectionRank},
+		{"supertrib.", parsed.SupertribeRank},
+		{"ab. n.", parsed.AberrationRank},
+		{"f.sp.", parsed.FormaSpecialisRank},
+		{"pv.", parsed.PathovarRank},
+		{"β", parsed.InfraspecificRank},
+	}
+
+	for _, v := range tests {
+		assert.Equal(t, parsed.NewRank(v.marker), v.rank, v.marker)
+	}
+}
+
+func TestJSONRank(t *testing.T) {
+	type dataOb struct {
+		Rank parsed.Rank `json:"rank"`
+	}
+	data := []struct {
+		dob dataOb
+		res string
+	}{
+		{dataOb{parsed.NoRank}, `{"rank":""}`},
+		{dataOb{parsed.SpeciesRank}, `{"rank":"SPECIES"}`},
+		{dataOb{parsed.FormaSpecialisRank}, `{"rank":"FORMA_SPECIALIS"}`},
+		{dataOb{parsed.InfraspecificRank}, `{"rank":"INFRASPECIFIC_NAME"}`},
+	}
+	enc := gnfmt.GNjson{}
+	for _, v := range data {
+		var dob dataOb
+		res, err := enc.Encode(v.dob)
+		assert.Nil(t, err)
+		assert.Equal(t, string(res), v.res)
+		err = enc.Decode(res, &dob)
+		assert.Nil(t, err)
+		assert.Equal(t, dob.Rank, v.dob.Rank)
+	}
+
+	var r parsed.Rank
+	err := r.UnmarshalJSON([]byte(`"subsp."`))
+	assert.NotNil(t, err)
+}
+
+func TestParsedRank(t *testing.T) {
+	tests := []struct {
+		msg, name string
+		code      nomcode.Code
+		rank      parsed.Rank
+		infRanks  []parsed.Rank
+	}{
+		{"uninomial", "Aus L.", nomcode.Unknown, parsed.NoRank, nil},
+		{"subgenus", "Pereskia subg. Maihuenia Phil.", nomcode.Unknown,
+			parsed.SubgenusRank, nil},
+		{"binomial", "Aus bus L.", nomcode.Unknown, parsed.SpeciesRank, nil},
+		{"ssp", "Aus bus ssp. cus", nomcode.Unknown, parsed.SubspeciesRank,
+			[]parsed.Rank{parsed.SubspeciesRank}},
+		{"fma", "Aus bus fma cus", nomcode.Unknown, parsed.FormRank,
+			[]parsed.Rank{parsed.FormRank}},
+		{"zoo trinomial", "Aus bus cus Linnaeus, 1758", nomcode.Zoological,
+			parsed.SubspeciesRank, []parsed.Rank{parsed.SubspeciesRank}},
+		{"no code trinomial", "Aus bus cus", nomcode.Unknown,
+			parsed.InfraspecificRank, []parsed.Rank{parsed.InfraspecificRank}},
+		{"inferred zoo trinomial", "Aus bus cus Smith, 1900", nomcode.Unknown,
+			parsed.SubspeciesRank, []parsed.Rank{parsed.SubspeciesRank}},
+		{"bac trinomial", "Aus bus cus", nomcode.Bacterial,
+			parsed.InfraspecificRank, []parsed.Rank{parsed.InfraspecificRank}},
+		{"bot trinomial", "Aus bus cus", nomcode.Botanical,
+			parsed.InfraspecificRank, []parsed.Rank{parsed.InfraspecificRank}},
+		{"quadrinomial", "Aus bus var. cus dus", nomcode.Unknown,
+			parsed.InfraspecificRank,
+			[]parsed.Rank{parsed.VarietyRank, parsed.InfraspecificRank}},
+		{"comparison", "Aus cf. bus", nomcode.Unknown, parsed.SpeciesRank, nil},
+		{"approximation", "Aus sp.", nomcode.Unknown, parsed.NoRank, nil},
+		{"named hybrid", "×Aus bus", nomcode.Unknown, parsed.SpeciesRank, nil},
+		{"hybrid formula", "Aus bus × Cus dus", nomcode.Unknown,
+			parsed.NoRank, nil},
+	}
+
+	for _, v := range tests {
+		cfg := gnparser.NewConfig(
+			gnparser.OptCode(v.code),
+			gnparser.OptWithDetails(true),
+		)
+		gnp := gnparser.New(cfg)
+		p := gnp.ParseName(v.name)
+		assert.Equal(t, p.Rank, v.rank, v.msg)
+		if v.infRanks == nil {
+			continue
+		}
+		d, ok := p.Details.(parsed.DetailsInfraspecies)
+		assert.True(t, ok, v.msg)
+		var ranks []parsed.Rank
+		for _, isp := range d.Infraspecies.Infraspecies {
+			ranks = append(ranks, isp.RankNormalized)
+		}
+		assert.Equal(t, ranks, v.infRanks, v.msg)
+	}
+
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	p := gnp.ParseName("Aus bus ssp cus")
+	d := p.Details.(parsed.DetailsInfraspecies)
+	assert.Equal(t, d.Infraspecies.Infraspecies[0].Rank, "subsp.")
+}
+
+func TestUninomialSuffixRank(t *testing.T) {
+	tests := []struct {
+		msg, name string
+		code      nomcode.Code
+		rank      parsed.Rank
+		rankCode  nomcode.Code
+		warn      bool
+	}{
+		{"family bot", "Rosaceae", nomcode.Unknown,
+			parsed.FamilyRank, nomcode.Botanical, false},
+		{"family bac", "Bacillaceae", nomcode.Bacterial,
+			parsed.FamilyRank, nomcode.Bacterial, false},
+		{"order", "Agaricales", nomcode.Unknown,
+			parsed.OrderRank, nomcode.Botanical, false},
+		{"subfamily", "Rosoideae", nomcode.Unknown,
+			parsed.SubfamilyRank, nomcode.Botanical, false},
+		{"tribe", "Poeae", nomcode.Unknown,
+			parsed.TribeRank, nomcode.Botanical, false},
+		{"class", "Bryopsida", nomcode.Unknown,
+			parsed.ClassRank, nomcode.Botanical, false},
+		{"algae subclass", "Fucophycidae", nomcode.Unknown,
+			parsed.SubclassRank, nomcode.Botanical, false},
+		{"phylum", "Bacillota", nomcode.Unknown,
+			parsed.PhylumRank, nomcode.Bacterial, false},
+		{"superfamily", "Papilionoidea", nomcode.Unknown,
+			parsed.SuperfamilyRank, nomcode.Zoological, false},
+		{"zoo tribe", "Bombini", nomcode.Unknown,
+			parsed.TribeRank, nomcode.Zoological, false},
+		{"ambiguous idae", "Felidae", nomcode.Unknown,
+			parsed.NoRank, nomcode.Unknown, true},
+		{"idae zoo", "Felidae", nomcode.Zoological,
+			parsed.FamilyRank, nomcode.Zoological, false},
+		{"idae bot", "Asteridae", nomcode.Botanical,
+			parsed.SubclassRank, nomcode.Botanical, false},
+		{"idae inferred zoo", "Felidae Gray, 1821", nomcode.Unknown,
+			parsed.FamilyRank, nomcode.Zoological, false},
+		{"ambiguous inae", "Carabinae", nomcode.Unknown,
+			parsed.NoRank, nomcode.Unknown, true},
+		{"inae zoo", "Carabinae", nomcode.Zoological,
+			parsed.SubfamilyRank, nomcode.Zoological, false},
+		{"inae cult", "Malinae", nomcode.Cultivars,
+			parsed.SubtribeRank, nomcode.Botanical, false},
+		{"not mandated", "Lepidoptera", nomcode.Unknown,
+			parsed.NoRank, nomcode.Unknown, false},
+		{"genus", "Homo", nomcode.Unknown,
+			parsed.NoRank, nomcode.Unknown, false},
+		{"short genus", "Lota", nomcode.Unknown,
+			parsed.NoRank, nomcode.Unknown, false},
+		{"ota genus", "Biota", nomcode.Unknown,
+			parsed.NoRank, nomcode.Unknown, false},
+		{"oidea genus", "Ascoidea", nomcode.Unknown,
+			parsed.NoRank, nomcode.Unknown, false},
+		{"ambiguous quality", "Akeratidae Nomen Nudum", nomcode.Unknown,
+			parsed.NoRank, nomcode.Unknown, true},
+		{"combination", "Pereskia subg. Maihuenia", nomcode.Unknown,
+			parsed.SubgenusRank, nomcode.Unknown, false},
+	}
+
+	for _, v := range tests {
+		cfg := gnparser.NewConfig(
+			gnparser.OptCode(v.code),
+			gnparser.OptWithDetails(true),
+		)
+		gnp := gnparser.New(cfg)
+		p := gnp.ParseName(v.name)
+		assert.Equal(t, p.Rank, v.rank, v.msg)
+		d, ok := p.Details.(parsed.DetailsUninomial)
+		assert.True(t, ok, v.msg)
+		assert.Equal(t, d.Uninomial.RankNormalized, v.rank, v.msg)
+		assert.Equal(t, d.Uninomial.RankCode, v.rankCode, v.msg)
+
+		var warn bool
+		for _, w := range p.QualityWarnings {
+			if w.Warning == parsed.RankSuffixAmbiguousWarn {
+				warn = true
+				assert.Equal(t, 1, w.Quality, v.msg)
+			}
+		}
+		assert.Equal(t, warn, v.warn, v.msg)
+	}
+}
@@ -0,0 +1,240 @@
+package parsed
+
+import (
+	"strings"
+
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/str"
+)
+
+// Renderer creates name-strings from details of parsed names. It allows
+// to print names consistently after their details were edited, or to
+// build names from atomized fields.
+type Renderer struct {
+	// Code sets citation conventions. The zoological code puts a comma
+	// before a year, keeps only the original authorship in parentheses,
+	// ignores authors before 'ex' and 'emend.' authors, and does not use
+	// rank markers for subspecies. Other codes use botanical conventions:
+	// rank markers, 'ex' authors and no comma before a year. Only rank
+	// markers given in details are used, inferred ranks do not add markers.
+	Code nomcode.Code
+
+	// WithAuthorship adds authorships of all elements of a name.
+	WithAuthorship bool
+
+	// AbbreviatedGenus shortens genus of binomial and infraspecific names
+	// to its first letter, for example "H. sapiens".
+	AbbreviatedGenus bool
+}
+
+// namePrefixes are prefixes of normalized names that are not kept in
+// Details: hybrid and graft-chimera signs of named genera, and "Candidatus".
+var namePrefixes = []string{"× ", "+ ", "Candidatus "}
+
+// RenderParsed creates a name-string from a parsed name. The name has to
+// be parsed with details, otherwise its canonical form (or normalized name
+// with authorship) is returned as is. Empty string is returned if the name
+// was not parsed.
+func (r Renderer) RenderParsed(p Parsed) string {
+	if !p.Parsed {
+		return ""
+	}
+	if p.Details == nil {
+		if r.WithAuthorship || p.Canonical == nil {
+			return p.Normalized
+		}
+		return p.Canonical.Full
+	}
+
+	var hybridEpithet bool
+	switch d := p.Details.(type) {
+	case DetailsSpecies:
+		hybridEpithet = strings.HasPrefix(p.Normalized, d.Species.Genus+" × ")
+	case DetailsInfraspecies:
+		hybridEpithet = strings.HasPrefix(
+			p.Normalized, d.Infraspecies.Genus+" × ",
+		)
+	}
+	res := r.render(p.Details, hybridEpithet)
+
+	for _, v := range namePrefixes {
+		if strings.HasPrefix(p.Normalized, v) {
+			return v + res
+		}
+	}
+	return res
+}
+
+// Render creates a name-string from details of a name.
+func (r Renderer) Render(d Details) string {
+	return r.render(d, false)
+}
+
+func (r Renderer) render(d Details, hybridEpithet bool) string {
+	switch dt := d.(type) {
+	case DetailsUninomial:
+		return r.uninomial(dt.Uninomial)
+	case DetailsSpecies:
+		return r.species(dt.Species, hybridEpithet)
+	case DetailsInfraspecies:
+		res := r.species(dt.Infraspecies.Species, hybridEpithet)
+		for _, v := range dt.Infraspecies.Infraspecies {
+			res = join(res, r.infraspecies(v))
+		}
+		return res
+	case DetailsComparison:
+		c := dt.Comparison
+		res := join(r.genus(c.Genus, c.Species != ""), c.CompMarker)
+		res = join(res, c.Species)
+		res = join(res, r.authorship(c.SpeciesAuthorship))
+		return join(res, c.Cultivar)
+	case DetailsApproximation:
+		a := dt.Approximation
+		res := join(r.genus(a.Genus, a.Species != ""), a.Species)
+		res = join(res, r.authorship(a.SpeciesAuthorship))
+		return join(res, a.ApproxMarker)
+	case DetailsHybridFormula:
+		return r.formula(dt.HybridFormula, "×")
+	case DetailsGraftChimeraFormula:
+		return r.formula(dt.GraftChimeraFormula, "+")
+	case DetailsVirus:
+		v := dt.Virus
+		res := join(v.Name, v.Strain)
+		if v.Acronym != "" {
+			res = join(res, "("+v.Acronym+")")
+		}
+		return res
+	}
+	return ""
+}
+
+func (r Renderer) uninomial(u Uninomial) string {
+	res := u.Value
+	if u.Parent != "" {
+		if r.Code == nomcode.Zoological && u.RankNormalized == SubgenusRank {
+			res = u.Parent + " (" + u.Value + ")"
+		} else {
+			res = join(join(u.Parent, u.Rank), u.Value)
+		}
+	}
+	res = join(res, r.authorship(u.Authorship))
+	return join(res, u.Cultivar)
+}
+
+func (r Renderer) species(sp Species, hybridEpithet bool) string {
+	res := r.genus(sp.Genus, true)
+	if sp.Subgenus != "" {
+		res = join(res, "("+sp.Subgenus+")")
+	}
+	if hybridEpithet {
+		res = join(res, "×")
+	}
+	res = join(res, sp.Species)
+	res = join(res, r.authorship(sp.Authorship))
+	return join(res, sp.Cultivar)
+}
+
+func (r Renderer) infraspecies(isp InfraspeciesElem) string {
+	var res string
+	if r.Code != nomcode.Zoological || isp.RankNormalized != SubspeciesRank {
+		res = isp.Rank
+	}
+	res = join(res, isp.Value)
+	return join(res, r.authorship(isp.Authorship))
+}
+
+func (r Renderer) formula(ds []Details, sign string) string {
+	res := make([]string, len(ds))
+	for i, v := range ds {
+		res[i] = r.Render(v)
+	}
+	return strings.Join(res, " "+sign+" ")
+}
+
+// genus abbreviates the genus if it is followed by an epithet and the
+// renderer uses abbreviated genera.
+func (r Renderer) genus(g string, withEpithet bool) string {
+	if !r.AbbreviatedGenus || !withEpithet || strings.HasSuffix(g, ".") {
+		return g
+	}
+	for _, v := range g {
+		return string(v) + "."
+	}
+	return g
+}
+
+// authorship renders an authorship from its authors groups. Authorships
+// without groups are rendered as normalized.
+func (r Renderer) authorship(au *Authorship) string {
+	if !r.WithAuthorship || au == nil {
+		return ""
+	}
+	if au.Original == nil {
+		return au.Normalized
+	}
+	res := r.authGroup(au.Original)
+	if au.Combination == nil && !strings.HasPrefix(au.Normalized, "(") {
+		return res
+	}
+	res = "(" + res + ")"
+	if au.Combination == nil || r.Code == nomcode.Zoological {
+		return res
+	}
+	return join(res, r.authGroup(au.Combination))
+}
+
+func (r Renderer) authGroup(ag *AuthGroup) string {
+	if ag.ExAuthors != nil && r.Code == nomcode.Zoological {
+		yr := ag.ExAuthors.Year
+		if yr == nil {
+			yr = ag.Year
+		}
+		return r.withYear(authors(ag.ExAuthors.Authors), yr)
+	}
+
+	res := r.withYear(authors(ag.Authors), ag.Year)
+	if ag.ExAuthors != nil {
+		ex := r.withYear(authors(ag.ExAuthors.Authors), ag.ExAuthors.Year)
+		res = join(res, "ex "+ex)
+	}
+	if ag.EmendAuthors != nil && r.Code != nomcode.Zoological {
+		emend := r.withYear(
+			authors(ag.EmendAuthors.Authors), ag.EmendAuthors.Year,
+		)
+		res = join(res, "emend. "+emend)
+	}
+	return res
+}
+
+func (r Renderer) withYear(aus string, yr *Year) string {
+	if yr == nil || yr.Value == "" {
+		return aus
+	}
+	y := yr.Value
+	if yr.IsApproximate {
+		y = "(" + y + ")"
+	}
+	if aus == "" {
+		return y
+	}
+	if r.Code == nomcode.Zoological {
+		return aus + ", " + y
+	}
+	return aus + " " + y
+}
+
+// authors joins authors of a team, for example "Smith, Jones & Brown".
+func authors(aus []string) string {
+	switch len(aus) {
+	case 0:
+		return ""
+	case 1:
+		return aus[0]
+	}
+	last := len(aus) - 1
+	return strings.Join(aus[:last], ", ") + " & " + aus[last]
+}
+
+func join(s1, s2 string) string {
+	return str.JoinStrings(s1, s2, " ")
+}
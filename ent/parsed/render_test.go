@@ -0,0 +1,107 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderParsed(t *testing.T) {
+	icn := parsed.Renderer{Code: nomcode.Botanical, WithAuthorship: true}
+	iczn := parsed.Renderer{Code: nomcode.Zoological, WithAuthorship: true}
+	abbr := parsed.Renderer{AbbreviatedGenus: true}
+	tests := []struct {
+		msg, name string
+		r         parsed.Renderer
+		res       string
+	}{
+		{"canonical", "Aus bus L. var. cus Mill.", parsed.Renderer{},
+			"Aus bus var. cus"},
+		{"icn infrasp",
+			"Agalinis purpurea (L.) Briton var. borealis (Berg.) Peterson 1987",
+			icn,
+			"Agalinis purpurea (L.) Briton var. borealis (Berg.) Peterson 1987"},
+		{"icn ex", "Aus bus Mill. ex L. 1753", icn, "Aus bus Mill. ex L. 1753"},
+		{"icn emend", "Aus bus L. emend. Mill.", icn, "Aus bus L. emend. Mill."},
+		{"icn year", "Aus bus Linnaeus, 1758", icn, "Aus bus Linnaeus 1758"},
+		{"icn team", "Aus bus Smith, Jones and Brown 1900", icn,
+			"Aus bus Smith, Jones & Brown 1900"},
+		{"icn unranked", "Aus bus cus", icn, "Aus bus cus"},
+		{"icn inferred subsp", "Aus bus cus Smith, 1900", icn,
+			"Aus bus cus Smith 1900"},
+		{"iczn year", "Pardosa moesta Banks 1892", iczn,
+			"Pardosa moesta Banks, 1892"},
+		{"iczn parens", "Aus bus (L., 1758) Mill. 1800", iczn,
+			"Aus bus (L., 1758)"},
+		{"iczn parens no comb", "Aus bus (Linnaeus 1758)", iczn,
+			"Aus bus (Linnaeus, 1758)"},
+		{"iczn ex", "Aus bus Linnaeus 1758 ex Smith 1760", iczn,
+			"Aus bus Smith, 1760"},
+		{"iczn subsp", "Aus bus ssp. cus Smith, 1900", iczn,
+			"Aus bus cus Smith, 1900"},
+		{"iczn approx year", "Aus bus L. 188?", iczn, "Aus bus L., (188?)"},
+		{"iczn subgenus", "Aus (Bus) cus Smith, 1900", iczn,
+			"Aus (Bus) cus Smith, 1900"},
+		{"iczn uninomial subgenus", "Pereskia subg. Maihuenia Phil.", iczn,
+			"Pereskia (Maihuenia) Phil."},
+		{"icn uninomial subgenus", "Pereskia subg. Maihuenia Phil.", icn,
+			"Pereskia subgen. Maihuenia Phil."},
+		{"abbr", "Homo sapiens Linnaeus, 1758", abbr, "H. sapiens"},
+		{"abbr infrasp", "Aus bus var. cus", abbr, "A. bus var. cus"},
+		{"abbr inferred subsp", "Aus bus cus Smith, 1900", abbr, "A. bus cus"},
+		{"abbr uninomial", "Homo Linnaeus, 1758", abbr, "Homo"},
+		{"abbr hybrid formula", "Aus bus × Cus dus", abbr, "A. bus × C. dus"},
+		{"named genus hybrid", "×Agropogon littoralis Smith", icn,
+			"× Agropogon littoralis Smith"},
+		{"named species hybrid", "Aus × bus L.", icn, "Aus × bus L."},
+		{"comparison", "Aus cf. bus L.", icn, "Aus cf. bus L."},
+		{"approximation", "Aus sp. L.", icn, "Aus sp."},
+		{"candidatus", "Candidatus Aus bus", icn, "Candidatus Aus bus"},
+		{"virus strain", "Escherichia phage T4", icn, "Escherichia phage T4"},
+		{"virus acronym", "Tobacco mosaic virus strain U1 (TMV)", icn,
+			"Tobacco mosaic virus U1 (TMV)"},
+		{"not parsed", "don't parse me", icn, ""},
+	}
+
+	cfg := gnparser.NewConfig(gnparser.OptWithDetails(true))
+	gnp := gnparser.New(cfg)
+	for _, v := range tests {
+		p := gnp.ParseName(v.name)
+		assert.Equal(t, v.r.RenderParsed(p), v.res, v.msg)
+	}
+}
+
+func TestRender(t *testing.T) {
+	d := parsed.DetailsInfraspecies{
+		Infraspecies: parsed.Infraspecies{
+			Species: parsed.Species{
+				Genus:   "Aus",
+				Species: "bus",
+				Authorship: &parsed.Authorship{
+					Original: &parsed.AuthGroup{
+						Authors: []string{"Smith", "Jones"},
+						Year:    &parsed.Year{Value: "1900"},
+					},
+				},
+			},
+			Infraspecies: []parsed.InfraspeciesElem{
+				{Value: "cus", Rank: "var.", RankNormalized: parsed.VarietyRank},
+				{Value: "dus", Rank: "f.", RankNormalized: parsed.FormRank,
+					Authorship: &parsed.Authorship{Normalized: "Mill."}},
+			},
+		},
+	}
+	r := parsed.Renderer{WithAuthorship: true}
+	assert.Equal(t, r.Render(d), "Aus bus Smith & Jones 1900 var. cus f. dus Mill.")
+	r = parsed.Renderer{Code: nomcode.Zoological, AbbreviatedGenus: true}
+	assert.Equal(t, r.Render(d), "A. bus var. cus f. dus")
+
+	d.Infraspecies.Infraspecies = []parsed.InfraspeciesElem{
+		{Value: "cus", RankNormalized: parsed.SubspeciesRank},
+	}
+	r = parsed.Renderer{Code: nomcode.Botanical}
+	assert.Equal(t, "Aus bus cus", r.Render(d))
+}
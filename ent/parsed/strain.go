@@ -0,0 +1,22 @@
+package parsed
+
+// Strain are details about a strain of a bacterial name, for example
+// "Escherichia coli O157:H7 str. Sakai".
+type Strain struct {
+	// Verbatim is the strain information as it was found after the name.
+	// It is empty if the strain information is a part of the name, for
+	// example "Pseudomonas syringae pv. tomato".
+	Verbatim string `json:"verbatim,omitempty"`
+	// Designation is a strain designation, for example "Sakai" or "K-12".
+	Designation string `json:"designation,omitempty"`
+	// Serovar is a serological variety, for example "Typhimurium" or
+	// "O157:H7".
+	Serovar string `json:"serovar,omitempty"`
+	// Pathovar is a pathological variety, for example "tomato".
+	Pathovar string `json:"pathovar,omitempty"`
+	// Biovar is a biological variety, for example "viciae".
+	Biovar string `json:"biovar,omitempty"`
+	// Accessions are accession numbers of the strain in culture
+	// collections, for example "ATCC 12345" or "DSM 10".
+	Accessions []string `json:"accessions,omitempty"`
+}
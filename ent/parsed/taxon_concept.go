@@ -0,0 +1,84 @@
+package parsed
+
+import (
+	"errors"
+	"strings"
+)
+
+// TaxonConcept are details about a taxon concept qualifier that follows a
+// name, for example "Aus bus sensu Smith, 1900", "Aus bus auct. non L.", or
+// "Aus bus s.l.".
+type TaxonConcept struct {
+	// Verbatim is the taxon concept information as it was found
+	// after the name.
+	Verbatim string `json:"verbatim"`
+	// Type is the kind of the taxon concept qualifier.
+	Type ConceptType `json:"type"`
+	// According are authors (and year) the concept is used according to,
+	// for example "Smith, 1900" for "sensu Smith, 1900".
+	According string `json:"according,omitempty"`
+	// Non are authors excluded from the concept, for example "L." for
+	// "auct. non L.".
+	Non string `json:"non,omitempty"`
+	// ProParte is true if the name is used "in part" (p.p.).
+	ProParte bool `json:"proParte,omitempty"`
+}
+
+// ConceptType is a type of a taxon concept qualifier.
+type ConceptType int
+
+const (
+	// NoConcept means absence of a taxon concept qualifier.
+	NoConcept ConceptType = iota
+	// SensuConcept is a concept according to some authors (sensu, sec.).
+	SensuConcept
+	// AuctorumConcept is a concept of authors other than the original
+	// author (auct.).
+	AuctorumConcept
+	// SensuLatoConcept is a concept in a broad sense (s.l.).
+	SensuLatoConcept
+	// SensuStrictoConcept is a concept in a narrow sense (s.str.).
+	SensuStrictoConcept
+	// ProParteConcept is a concept that includes only a part of the
+	// taxon (p.p.).
+	ProParteConcept
+)
+
+var conceptTypeMap = map[ConceptType]string{
+	NoConcept:           "",
+	SensuConcept:        "SENSU",
+	AuctorumConcept:     "AUCTORUM",
+	SensuLatoConcept:    "SENSU_LATO",
+	SensuStrictoConcept: "SENSU_STRICTO",
+	ProParteConcept:     "PRO_PARTE",
+}
+
+var conceptTypeStrMap = func() map[string]ConceptType {
+	res := make(map[string]ConceptType)
+	for k, v := range conceptTypeMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// String is an implementation of fmt.Stringer interface.
+func (ct ConceptType) String() string {
+	return conceptTypeMap[ct]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (ct ConceptType) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + ct.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (ct *ConceptType) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*ct, ok = conceptTypeStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode ConceptType")
+	}
+	return err
+}
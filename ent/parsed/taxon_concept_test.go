@@ -0,0 +1,26 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONConceptType(t *testing.T) {
+	enc := gnfmt.GNjson{}
+	tc := parsed.TaxonConcept{
+		Verbatim: "auct. non L.",
+		Type:     parsed.AuctorumConcept,
+		Non:      "L.",
+	}
+	res, err := enc.Encode(tc)
+	assert.Nil(t, err)
+	assert.Equal(t, string(res),
+		`{"verbatim":"auct. non L.","type":"AUCTORUM","non":"L."}`)
+	var tc2 parsed.TaxonConcept
+	err = enc.Decode(res, &tc2)
+	assert.Nil(t, err)
+	assert.Equal(t, tc2, tc)
+}
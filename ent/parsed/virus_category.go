@@ -0,0 +1,84 @@
+package parsed
+
+import (
+	"errors"
+	"strings"
+)
+
+// VirusCategory is a category of a sub-cellular entity. These entities
+// are marked by Virus field of Parsed output.
+type VirusCategory int
+
+const (
+	// NoVirusCat is used for names of cellular organisms.
+	NoVirusCat VirusCategory = iota
+	// VirusCat is a name of a virus.
+	VirusCat
+	// BacteriophageCat is a name of a virus that infects bacteria.
+	BacteriophageCat
+	// SatelliteCat is a name of a satellite virus or satellite nucleic acid.
+	SatelliteCat
+	// ViroidCat is a name of a viroid.
+	ViroidCat
+	// PlasmidCat is a name of a plasmid.
+	PlasmidCat
+	// PrionCat is a name of a prion.
+	PrionCat
+	// VectorCat is a name of a cloning or expression vector.
+	VectorCat
+	// NucleicAcidCat is a name of RNA or DNA.
+	NucleicAcidCat
+)
+
+var virusCatMap = map[VirusCategory]string{
+	NoVirusCat:       "",
+	VirusCat:         "VIRUS",
+	BacteriophageCat: "BACTERIOPHAGE",
+	SatelliteCat:     "SATELLITE",
+	ViroidCat:        "VIROID",
+	PlasmidCat:       "PLASMID",
+	PrionCat:         "PRION",
+	VectorCat:        "VECTOR",
+	NucleicAcidCat:   "NUCLEIC_ACID",
+}
+
+var virusCatStrMap = func() map[string]VirusCategory {
+	res := make(map[string]VirusCategory)
+	for k, v := range virusCatMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// IsVirus returns true for categories that are regulated by
+// the International Code of Virus Classification and Nomenclature.
+func (vc VirusCategory) IsVirus() bool {
+	switch vc {
+	case VirusCat, BacteriophageCat, SatelliteCat, ViroidCat:
+		return true
+	default:
+		return false
+	}
+}
+
+// String is an implementation of fmt.Stringer interface.
+func (vc VirusCategory) String() string {
+	return virusCatMap[vc]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (vc VirusCategory) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + vc.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (vc *VirusCategory) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*vc, ok = virusCatStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode VirusCategory")
+	}
+	return err
+}
@@ -0,0 +1,40 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONVirusCategory(t *testing.T) {
+	type dataOb struct {
+		Cat parsed.VirusCategory `json:"cat"`
+	}
+	data := []struct {
+		dob     dataOb
+		res     string
+		isVirus bool
+	}{
+		{dataOb{parsed.NoVirusCat}, `{"cat":""}`, false},
+		{dataOb{parsed.BacteriophageCat}, `{"cat":"BACTERIOPHAGE"}`, true},
+		{dataOb{parsed.PlasmidCat}, `{"cat":"PLASMID"}`, false},
+		{dataOb{parsed.NucleicAcidCat}, `{"cat":"NUCLEIC_ACID"}`, false},
+	}
+	enc := gnfmt.GNjson{}
+	for _, v := range data {
+		var dob dataOb
+		res, err := enc.Encode(v.dob)
+		assert.Nil(t, err)
+		assert.Equal(t, string(res), v.res)
+		err = enc.Decode(res, &dob)
+		assert.Nil(t, err)
+		assert.Equal(t, dob.Cat, v.dob.Cat)
+		assert.Equal(t, v.dob.Cat.IsVirus(), v.isVirus)
+	}
+
+	var cat parsed.VirusCategory
+	err := cat.UnmarshalJSON([]byte(`"FUNGUS"`))
+	assert.NotNil(t, err)
+}
@@ -48,6 +48,7 @@ const (
 	LowCaseWarn
 	NameApproxWarn
 	NameComparisonWarn
+	RankSuffixAmbiguousWarn
 	RankUncommonWarn
 	SpaceNonStandardWarn
 	SpanishAndAsSeparator
@@ -58,13 +59,16 @@ const (
 	UninomialComboWarn
 	WhiteSpaceTrailWarn
 	YearCharWarn
+	YearCombBeforeOrigWarn
 	YearDotWarn
+	YearFutureWarn
 	YearOrigMisplacedWarn
 	YearPageWarn
 	YearParensWarn
 	YearQuestionWarn
 	YearRangeWarn
 	YearSqBracketsWarn
+	YearTooEarlyWarn
 )
 
 var warningMap = map[Warning]string{
@@ -106,6 +110,7 @@ var warningMap = map[Warning]string{
 	LowCaseWarn:                           "Name starts with low-case character",
 	NameApproxWarn:                        "Name is approximate",
 	NameComparisonWarn:                    "Name comparison",
+	RankSuffixAmbiguousWarn:               "Ambiguous rank suffix (differs between codes)",
 	RankUncommonWarn:                      "Uncommon rank",
 	SpaceNonStandardWarn:                  "Non-standard space characters",
 	SpanishAndAsSeparator:                 "Spanish 'y' is used instead of '&'",
@@ -116,13 +121,16 @@ var warningMap = map[Warning]string{
 	UninomialComboWarn:                    "Combination of two uninomials",
 	WhiteSpaceTrailWarn:                   "Trailing whitespace",
 	YearCharWarn:                          "Year with latin character",
+	YearCombBeforeOrigWarn:                "Combination year is earlier than basionym year",
 	YearDotWarn:                           "Year with period",
+	YearFutureWarn:                        "Year is in the future",
 	YearOrigMisplacedWarn:                 "Misplaced basionym year",
 	YearPageWarn:                          "Year with page info",
 	YearParensWarn:                        "Year with parentheses",
 	YearQuestionWarn:                      "Year with question mark",
 	YearRangeWarn:                         "Years range",
 	YearSqBracketsWarn:                    "Year with square brackets",
+	YearTooEarlyWarn:                      "Year is earlier than the start of nomenclature",
 }
 
 var warningStrMap = func() map[string]Warning {
@@ -173,6 +181,7 @@ var WarningQualityMap = map[Warning]int{
 	LowCaseWarn:                           4,
 	NameApproxWarn:                        4,
 	NameComparisonWarn:                    4,
+	RankSuffixAmbiguousWarn:               1,
 	RankUncommonWarn:                      3,
 	SpaceNonStandardWarn:                  2,
 	SpanishAndAsSeparator:                 2,
@@ -183,13 +192,16 @@ var WarningQualityMap = map[Warning]int{
 	UninomialComboWarn:                    2,
 	WhiteSpaceTrailWarn:                   2,
 	YearCharWarn:                          2,
+	YearCombBeforeOrigWarn:                3,
 	YearDotWarn:                           2,
+	YearFutureWarn:                        3,
 	YearOrigMisplacedWarn:                 2,
 	YearPageWarn:                          2,
 	YearParensWarn:                        2,
 	YearQuestionWarn:                      2,
 	YearRangeWarn:                         3,
 	YearSqBracketsWarn:                    3,
+	YearTooEarlyWarn:                      3,
 }
 
 // QualityWarning is and object that contains the warning and its
@@ -64,6 +64,13 @@ const (
 	UninomialType
 	YearApproximateType
 	YearType
+	VirusNameType
+	VirusStrainType
+	VirusAcronymType
+	ConceptQualifierType
+	ConceptAuthorType
+	ConceptYearType
+	NomStatusType
 )
 
 var wordTypeMap = map[WordType]string{
@@ -84,6 +91,13 @@ var wordTypeMap = map[WordType]string{
 	UninomialType:        "UNINOMIAL",
 	YearApproximateType:  "APPROXIMATE_YEAR",
 	YearType:             "YEAR",
+	VirusNameType:        "VIRUS_NAME",
+	VirusStrainType:      "VIRUS_STRAIN",
+	VirusAcronymType:     "VIRUS_ACRONYM",
+	ConceptQualifierType: "CONCEPT_QUALIFIER",
+	ConceptAuthorType:    "CONCEPT_AUTHOR",
+	ConceptYearType:      "CONCEPT_YEAR",
+	NomStatusType:        "NOMENCLATURAL_STATUS",
 }
 
 var wordTypeStrMap = func() map[string]WordType {
@@ -6,6 +6,7 @@ import (
 	"unicode"
 
 	"github.com/gnames/gnparser/ent/internal/preprocess"
+	"github.com/gnames/gnparser/ent/nomcode"
 
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/ent/str"
@@ -20,15 +21,23 @@ type scientificNameNode struct {
 	verbatimID       string
 	cardinality      int
 	virus            bool
+	virusCategory    parsed.VirusCategory
 	daggerChar       bool
 	hybrid           *parsed.Annotation
 	surrogate        *parsed.Annotation
 	bacteria         *tribool.Tribool
+	strain           *parsed.Strain
+	taxonConcept     *parsed.TaxonConcept
+	nomStatus        []parsed.NomenclaturalStatus
+	tailWords        []parsed.Word
 	tail             string
 	parserVersion    string
 	ambiguousEpithet string
 	ambiguousModif   string
+	code             nomcode.Code
+	earliestYear     int
 	warnings         map[parsed.Warning]struct{}
+	evidence         map[parsed.CodeEvidence]struct{}
 }
 
 func (p *Engine) newScientificNameNode() {
@@ -49,12 +58,14 @@ func (p *Engine) newScientificNameNode() {
 		tail = p.tail
 	}
 	sn := scientificNameNode{
-		nameData:    name,
-		cardinality: p.cardinality,
-		hybrid:      p.hybrid,
-		surrogate:   p.surrogate,
-		bacteria:    p.bacteria,
-		tail:        tail,
+		nameData:     name,
+		cardinality:  p.cardinality,
+		hybrid:       p.hybrid,
+		surrogate:    p.surrogate,
+		bacteria:     p.bacteria,
+		tail:         tail,
+		earliestYear: p.earliestYear,
+		evidence:     p.evidence,
 	}
 	p.sn = &sn
 }
@@ -393,6 +404,9 @@ func (p *Engine) newNamedGenusGraftChimeraNode(n *node32) *namedGenusGraftChimer
 }
 
 func (p *Engine) botanicalUninomial(n *node32) bool {
+	if p.code == nomcode.Zoological {
+		return false
+	}
 	n = n.up
 	if n.pegRule == ruleUninomial {
 		return false
@@ -403,11 +417,18 @@ func (p *Engine) botanicalUninomial(n *node32) bool {
 		return false
 	}
 	w := p.newWordNode(n, parsed.UnknownType)
+	return p.isICNAuthor(w)
+}
 
-	if _, ok := dict.Dict.AuthorICN[w.Normalized]; ok {
-		return true
+// isICNAuthor checks if a word in parentheses after a genus is an author
+// of the genus, and not a subgenus. For zoological names such word is
+// always a subgenus.
+func (p *Engine) isICNAuthor(w *parsed.Word) bool {
+	if p.code == nomcode.Zoological {
+		return false
 	}
-	return false
+	_, ok := dict.Dict.AuthorICN[w.Normalized]
+	return ok
 }
 
 func (p *Engine) newBotanicalUninomialNode(n *node32) *uninomialNode {
@@ -417,6 +438,7 @@ func (p *Engine) newBotanicalUninomialNode(n *node32) *uninomialNode {
 	n = n.next // fake Subgenus
 	au := p.newWordNode(n.up, parsed.AuthorWordType)
 	an := &authorNode{Value: au.Normalized, Words: []*parsed.Word{au}}
+	an.setNameParts([]authorPart{{val: au.Normalized}})
 	at := &authorsTeamNode{Authors: []*authorNode{an}}
 	ag := &authorsGroupNode{Team1: at, Parens: true}
 	n = n.next
@@ -595,7 +617,7 @@ func (p *Engine) newSpeciesNode(n *node32) *speciesNode {
 		switch n.pegRule {
 		case ruleSubgenus:
 			w := p.newWordNode(n.up, parsed.SubgenusType)
-			if _, ok := dict.Dict.AuthorICN[w.Normalized]; ok {
+			if p.isICNAuthor(w) {
 				p.addWarn(parsed.BotanyAuthorNotSubgenWarn)
 			} else {
 				sg = w
@@ -622,10 +644,8 @@ func (p *Engine) newSpeciesNode(n *node32) *speciesNode {
 		Infraspecies:    infs,
 		CultivarEpithet: cultivar,
 	}
-	if len(infs) > 0 && infs[0].Rank == nil && sp.Authorship != nil &&
-		sp.Authorship.TerminalFilius {
-		p.addWarn(parsed.AuthAmbiguousFiliusWarn)
-
+	if sp != nil {
+		p.resolveFilius(sp.Authorship, infs)
 	}
 	return &sn
 }
@@ -664,18 +684,114 @@ func (p *Engine) newInfraspeciesGroup(n *node32) []*infraspEpithetNode {
 	}
 	for n != nil {
 		inf := p.newInfraspEpithetNode(n)
-		if len(infs) > 0 && inf.Rank == nil {
-			infPrev := infs[len(infs)-1]
-			if infPrev.Authorship != nil && infPrev.Authorship.TerminalFilius {
-				p.addWarn(parsed.AuthAmbiguousFiliusWarn)
-			}
-		}
 		infs = append(infs, inf)
 		n = n.next
 	}
 	return infs
 }
 
+// resolveFilius decides if `f.` between an authorship and an infraspecific
+// epithet means 'filius' or 'forma'. For botanical names it is always
+// 'forma', for zoological names it is always 'filius'. If the code is not
+// known, the ambiguity is reported with a warning.
+func (p *Engine) resolveFilius(
+	au *authorshipNode,
+	infs []*infraspEpithetNode,
+) {
+	for _, inf := range infs {
+		switch p.code {
+		case nomcode.Botanical, nomcode.Cultivars:
+			if inf.Rank == nil {
+				filiusToForma(au, inf)
+			}
+		case nomcode.Zoological:
+			if inf.Rank != nil {
+				formaToFilius(au, inf)
+			}
+		default:
+			if inf.Rank == nil && au != nil && au.TerminalFilius {
+				p.addWarn(parsed.AuthAmbiguousFiliusWarn)
+			}
+		}
+		au = inf.Authorship
+	}
+}
+
+// terminalTeam returns the authors team that is the closest to the end
+// of the authorship.
+func (au *authorshipNode) terminalTeam() (*authorsGroupNode, *authorsTeamNode) {
+	ag := au.CombinationAuthors
+	if ag == nil {
+		if au.OriginalAuthors == nil || au.OriginalAuthors.Parens {
+			return nil, nil
+		}
+		ag = au.OriginalAuthors
+	}
+	if ag.Team2 != nil {
+		return ag, ag.Team2
+	}
+	return ag, ag.Team1
+}
+
+// filiusToForma converts terminal `f.` of an authorship to a 'forma' rank
+// of the following infraspecific epithet.
+func filiusToForma(au *authorshipNode, inf *infraspEpithetNode) {
+	if au == nil || !au.TerminalFilius {
+		return
+	}
+	ag, at := au.terminalTeam()
+	if at == nil || at.Year != nil {
+		return
+	}
+	a := at.Authors[len(at.Authors)-1]
+	w := a.Words[len(a.Words)-1]
+	if w.Verbatim != "f." {
+		return
+	}
+
+	a.Words = a.Words[:len(a.Words)-1]
+	a.Value = ""
+	for _, v := range a.Words {
+		a.Value = str.JoinStrings(a.Value, v.Normalized, " ")
+	}
+	a.Filius = false
+	at.TerminalFilius = false
+	ag.TerminalFilius = false
+	au.TerminalFilius = false
+	au.Verbatim = strings.TrimSpace(
+		strings.TrimSuffix(strings.TrimSpace(au.Verbatim), "f."),
+	)
+
+	w.Type = parsed.RankType
+	w.Normalized = "f."
+	inf.Rank = &rankNode{Word: w}
+}
+
+// formaToFilius converts `f.` rank of an infraspecific epithet to 'filius'
+// of the preceding authorship.
+func formaToFilius(au *authorshipNode, inf *infraspEpithetNode) {
+	if au == nil || au.TerminalFilius || inf.Rank.Word.Verbatim != "f." {
+		return
+	}
+	ag, at := au.terminalTeam()
+	if at == nil || at.Year != nil {
+		return
+	}
+
+	w := inf.Rank.Word
+	w.Type = parsed.AuthorWordFiliusType
+	w.Normalized = "fil."
+	a := at.Authors[len(at.Authors)-1]
+	a.Words = append(a.Words, w)
+	a.Value = str.JoinStrings(a.Value, w.Normalized, " ")
+	a.Filius = true
+	at.TerminalFilius = true
+	ag.TerminalFilius = true
+	au.TerminalFilius = true
+	au.Verbatim = strings.TrimSpace(au.Verbatim) + " " + w.Verbatim
+	inf.Rank = nil
+}
+
 func (p *Engine) newInfraspEpithetNode(n *node32) *infraspEpithetNode {
 	var inf infraspEpithetNode
 	var r *rankNode
@@ -870,6 +986,12 @@ func (p *Engine) newAuthorshipNode(n *node32) *authorshipNode {
 	fil = oa.TerminalFilius && !oa.Parens
 	if ca != nil {
 		fil = ca.TerminalFilius
+		p.addEvidence(parsed.CombinationAuthorsEvidence)
+		oy, cy := oa.year(), ca.year()
+		if oy != nil && cy != nil && oy.Start > 0 && cy.Start > 0 &&
+			cy.End < oy.Start {
+			p.addWarn(parsed.YearCombBeforeOrigWarn)
+		}
 	}
 
 	a = &authorshipNode{
@@ -898,6 +1020,18 @@ type authorsGroupNode struct {
 	TerminalFilius bool
 }
 
+// year returns the year of the group. If the main team has no year,
+// the year of 'ex' authors is used.
+func (ag *authorsGroupNode) year() *yearNode {
+	if ag.Team1 != nil && ag.Team1.Year != nil {
+		return ag.Team1.Year
+	}
+	if ag.Team2 != nil && ag.Team2Type == teamEx {
+		return ag.Team2.Year
+	}
+	return nil
+}
+
 func (p *Engine) newAuthorsGroupNode(n *node32) *authorsGroupNode {
 	var t1, t2 *authorsTeamNode
 	var t2t teamType
@@ -918,7 +1052,10 @@ func (p *Engine) newAuthorsGroupNode(n *node32) *authorsGroupNode {
 	}
 	switch n.pegRule {
 	case ruleAuthorEx:
-		p.addWarn(parsed.AuthExWarn)
+		p.addEvidence(parsed.ExAuthorsEvidence)
+		if p.code == nomcode.Unknown || p.code == nomcode.Zoological {
+			p.addWarn(parsed.AuthExWarn)
+		}
 		t2t = teamEx
 		t2wrd = p.newWordNode(n, parsed.AuthorWordType)
 		ex := strings.TrimSpace(t2wrd.Verbatim)
@@ -927,6 +1064,7 @@ func (p *Engine) newAuthorsGroupNode(n *node32) *authorsGroupNode {
 		}
 		t2wrd.Normalized = "ex"
 	case ruleAuthorEmend:
+		p.addEvidence(parsed.EmendAuthorsEvidence)
 		p.addWarn(parsed.AuthEmendWarn)
 		t2t = teamEmend
 		t2wrd = p.newWordNode(n, parsed.AuthorWordType)
@@ -1000,12 +1138,19 @@ type authorNode struct {
 	Sep    string
 	Words  []*parsed.Word
 	Filius bool
+	// Initials, Particles, Surname and Suffix are parts of the name
+	// of the author.
+	Initials  []string
+	Particles []string
+	Surname   string
+	Suffix    string
 }
 
 func (p *Engine) newAuthorNode(n *node32) *authorNode {
 	var w *parsed.Word
 	var fil bool
 	var ws []*parsed.Word
+	var parts []authorPart
 	val := ""
 	rawVal := ""
 	n = n.up
@@ -1027,8 +1172,16 @@ func (p *Engine) newAuthorNode(n *node32) *authorNode {
 			if strings.Contains(w.Normalized, "&") {
 				w.Normalized = "et al."
 			}
+		case ruleAuthorSuffix:
+			w = p.authorWord(n)
+			parts = append(parts, authorPart{val: w.Normalized, kind: suffixPart})
 		default:
 			w = p.authorWord(n)
+			part := authorPart{val: w.Normalized}
+			if n.up != nil && n.up.pegRule == ruleAuthorPrefix {
+				part.kind = particlePart
+			}
+			parts = append(parts, part)
 		}
 		ws = append(ws, w)
 		val = str.JoinStrings(val, w.Normalized, " ")
@@ -1043,6 +1196,7 @@ func (p *Engine) newAuthorNode(n *node32) *authorNode {
 		Words:  ws,
 		Filius: fil,
 	}
+	au.setNameParts(parts)
 	return &au
 }
 
@@ -1066,10 +1220,17 @@ func (p *Engine) authorWord(n *node32) *parsed.Word {
 type yearNode struct {
 	Word        *parsed.Word
 	Approximate bool
+	// Verbatim is the year as it is given in the name-string, for example
+	// "1880-1885" or "(1880)".
+	Verbatim string
+	// Start and End are the earliest and the latest years the year
+	// might mean, for example 1880 and 1889 for "188?".
+	Start, End int
 }
 
 func (p *Engine) newYearNode(nd *node32) *yearNode {
 	var w *parsed.Word
+	var rangeEnd string
 	appr := false
 	nodes := nd.flatChildren()
 	for _, v := range nodes {
@@ -1078,6 +1239,7 @@ func (p *Engine) newYearNode(nd *node32) *yearNode {
 			p.addWarn(parsed.YearPageWarn)
 		case ruleYearRange:
 			p.addWarn(parsed.YearRangeWarn)
+			rangeEnd = p.nodeValue(v)
 			appr = true
 		case ruleYearWithParens:
 			p.addWarn(parsed.YearParensWarn)
@@ -1108,7 +1270,13 @@ func (p *Engine) newYearNode(nd *node32) *yearNode {
 	yr := yearNode{
 		Word:        w,
 		Approximate: appr,
+		Verbatim:    p.nodeValue(nd),
+	}
+	yr.Start, yr.End = yearBounds(w.Normalized)
+	if rangeEnd != "" {
+		yr.End = yearRangeEnd(yr.Start, rangeEnd)
 	}
+	p.checkYear(&yr)
 	return &yr
 }
 
@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// authorPartKind tells what role a word plays in an author's name.
+type authorPartKind int
+
+const (
+	namePart authorPartKind = iota
+	particlePart
+	suffixPart
+)
+
+// authorPart is a normalized word of an author's name with its role
+// according to the grammar.
+type authorPart struct {
+	val  string
+	kind authorPartKind
+}
+
+var (
+	authorInitialRe = regexp.MustCompile(
+		`^(\p{Lu}(\p{Ll}{0,2}\.)?)(-\p{Lu}(\p{Ll}{0,2}\.)?)*$`,
+	)
+	authorGluedParticleRe = regexp.MustCompile(`^(d['’])(\p{Lu}.*)$`)
+)
+
+// authorSuffixes are words that might follow the surname of an author.
+var authorSuffixes = map[string]struct{}{
+	"bis":  {},
+	"ter":  {},
+	"Jr.":  {},
+	"jr.":  {},
+	"Jun.": {},
+	"jun.": {},
+	"Sr.":  {},
+	"sr.":  {},
+	"Sen.": {},
+	"sen.": {},
+}
+
+// setNameParts breaks words of an author's name into initials,
+// particles, surname and suffix. Particles and suffixes are marked by
+// the grammar, initials are the words that look like initials and precede
+// the last word of the name. Particles inside of a surname, like in
+// "Bory de St. Vincent", stay in the surname.
+func (aun *authorNode) setNameParts(parts []authorPart) {
+	var name []string
+	var inSurname bool
+	for i, v := range parts {
+		_, isSuffix := authorSuffixes[v.val]
+		last := i == len(parts)-1
+		switch {
+		case v.kind == suffixPart, last && len(name) > 0 && isSuffix:
+			aun.Suffix = v.val
+		case v.kind == particlePart && inSurname:
+			name = append(name, v.val)
+		case v.kind == particlePart:
+			aun.Particles = append(aun.Particles, v.val)
+		default:
+			if m := authorGluedParticleRe.FindStringSubmatch(v.val); m != nil {
+				aun.Particles = append(aun.Particles, m[1])
+				name = append(name, m[2])
+				inSurname = true
+				continue
+			}
+			name = append(name, v.val)
+			if !authorInitialRe.MatchString(v.val) {
+				inSurname = true
+			}
+		}
+	}
+
+	for i := range name {
+		if i == len(name)-1 || !authorInitialRe.MatchString(name[i]) {
+			aun.Surname = strings.Join(name[i:], " ")
+			break
+		}
+		aun.Initials = append(aun.Initials, name[i])
+	}
+}
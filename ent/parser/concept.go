@@ -0,0 +1,213 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+var (
+	conceptAuthorRe = regexp.MustCompile(
+		`^(\p{Lu}[\p{L}'.()-]*|&|et|ex|in|and|de|da|du|van|von|der|den|le|la|` +
+			`d'\p{L}*|f\.|fil\.)$`,
+	)
+	conceptYearRe = regexp.MustCompile(`^\d{4}[a-z]?(:\d*)?$`)
+	conceptPageRe = regexp.MustCompile(`^\d+$`)
+)
+
+// conceptMarkers are qualifiers that start a taxon concept.
+var conceptMarkers = map[string]parsed.ConceptType{
+	"sensu":     parsed.SensuConcept,
+	"sec.":      parsed.SensuConcept,
+	"sec":       parsed.SensuConcept,
+	"secundum":  parsed.SensuConcept,
+	"auct.":     parsed.AuctorumConcept,
+	"auct":      parsed.AuctorumConcept,
+	"auctt.":    parsed.AuctorumConcept,
+	"auctorum":  parsed.AuctorumConcept,
+	"s.l.":      parsed.SensuLatoConcept,
+	"s.lat.":    parsed.SensuLatoConcept,
+	"s.s.":      parsed.SensuStrictoConcept,
+	"s.str.":    parsed.SensuStrictoConcept,
+	"s.strict.": parsed.SensuStrictoConcept,
+	"p.p.":      parsed.ProParteConcept,
+}
+
+// conceptSecondWords are the second words of two-word qualifiers, like
+// "sensu lato", "s. str." or "pro parte".
+var conceptSecondWords = map[string]map[string]parsed.ConceptType{
+	"sensu": {
+		"lato":    parsed.SensuLatoConcept,
+		"latu":    parsed.SensuLatoConcept,
+		"l.":      parsed.SensuLatoConcept,
+		"stricto": parsed.SensuStrictoConcept,
+		"strictu": parsed.SensuStrictoConcept,
+		"str.":    parsed.SensuStrictoConcept,
+		"s.":      parsed.SensuStrictoConcept,
+	},
+	"s.": {
+		"l.":      parsed.SensuLatoConcept,
+		"lat.":    parsed.SensuLatoConcept,
+		"s.":      parsed.SensuStrictoConcept,
+		"str.":    parsed.SensuStrictoConcept,
+		"strict.": parsed.SensuStrictoConcept,
+		"stricto": parsed.SensuStrictoConcept,
+		"lato":    parsed.SensuLatoConcept,
+	},
+	"p.":  {"p.": parsed.ProParteConcept},
+	"pro": {"parte": parsed.ProParteConcept},
+}
+
+// addTaxonConcept finds taxon concept qualifiers at the start of the tail.
+// The full is the whole preprocessed name-string, it is used to find
+// positions of the concept words. Recognized part of the tail is removed
+// from it.
+func (sn *scientificNameNode) addTaxonConcept(full string) {
+	tail, offset, ok := sn.tailOffset(full)
+	if !ok {
+		return
+	}
+
+	tc, ws, rest := parseConcept(tail, offset)
+	if tc == nil {
+		return
+	}
+	sn.taxonConcept = tc
+	sn.tailWords = append(sn.tailWords, ws...)
+	sn.tail = rest
+}
+
+// tailOffset returns the tail without trailing spaces and its position
+// in the full preprocessed name-string. It returns false if the tail
+// cannot be annotated.
+func (sn *scientificNameNode) tailOffset(full string) (string, int, bool) {
+	if sn.nameData == nil || sn.virus || sn.tail == "" {
+		return "", 0, false
+	}
+	full = strings.TrimRight(full, " ")
+	tail := strings.TrimRight(sn.tail, " ")
+	if !strings.HasSuffix(full, tail) {
+		return "", 0, false
+	}
+	offset := utf8.RuneCountInString(full) - utf8.RuneCountInString(tail)
+	return tail, offset, true
+}
+
+// parseConcept parses taxon concept qualifiers at the start of a tail. It
+// returns the concept, its words, and the rest of the tail.
+func parseConcept(
+	tail string,
+	offset int,
+) (*parsed.TaxonConcept, []parsed.Word, string) {
+	toks := spaceTokens(tail)
+	var tc parsed.TaxonConcept
+	var ws []parsed.Word
+	var according, non []string
+	var auth *[]string
+	var i, end int
+
+loop:
+	for i < len(toks) {
+		t := toks[i].trimRight(",").trimLeft("(").trimRight(")")
+		val := strings.ToLower(t.val)
+
+		ct, n := conceptQualifier(toks, i)
+		switch {
+		case n > 0:
+			for j := i; j < i+n; j++ {
+				w := toks[j].trimRight(",").trimLeft("(").trimRight(")")
+				ws = append(ws, w.word(parsed.ConceptQualifierType))
+			}
+			if ct == parsed.ProParteConcept {
+				tc.ProParte = true
+			}
+			if tc.Type == parsed.NoConcept ||
+				(tc.Type == parsed.SensuConcept && len(according) == 0) {
+				tc.Type = ct
+			}
+			// only sensu, sec. and auct. are followed by authors of the
+			// concept, other qualifiers are followed by authors of the name.
+			auth = nil
+			if ct == parsed.SensuConcept || ct == parsed.AuctorumConcept {
+				auth = &according
+			}
+			i += n
+		case tc.Type == parsed.NoConcept:
+			return nil, nil, tail
+		case (val == "non" || val == "nec") && i+1 < len(toks) &&
+			conceptAuthorRe.MatchString(
+				toks[i+1].trimRight(",").trimLeft("(").trimRight(")").val,
+			):
+			ws = append(ws, t.word(parsed.ConceptQualifierType))
+			auth = &non
+			i++
+		case conceptYearRe.MatchString(t.val) && auth != nil && len(*auth) > 0:
+			ws = append(ws, conceptYear(t).word(parsed.ConceptYearType))
+			*auth = append(*auth, toks[i].val)
+			i++
+			// a page might follow the year after a colon, like "2001: 23".
+			if strings.HasSuffix(t.val, ":") && i < len(toks) &&
+				conceptPageRe.MatchString(toks[i].trimRight(",").val) {
+				*auth = append(*auth, toks[i].val)
+				i++
+			}
+		case conceptAuthorRe.MatchString(t.val) && auth != nil:
+			ws = append(ws, t.word(parsed.ConceptAuthorType))
+			*auth = append(*auth, toks[i].val)
+			i++
+		default:
+			break loop
+		}
+		end = toks[i-1].bytes
+	}
+
+	if tc.Type == parsed.NoConcept {
+		return nil, nil, tail
+	}
+	for i := range ws {
+		ws[i].Start += offset
+		ws[i].End += offset
+	}
+	tc.According = joinConceptAuthors(according)
+	tc.Non = joinConceptAuthors(non)
+	tc.Verbatim = strings.TrimSpace(tail[:end])
+	return &tc, ws, strings.TrimRight(tail[end:], " ")
+}
+
+// conceptQualifier checks if there is a qualifier at the i position. It
+// returns the type of the qualifier and the number of its tokens.
+func conceptQualifier(toks []spaceToken, i int) (parsed.ConceptType, int) {
+	norm := func(t spaceToken) string {
+		return strings.ToLower(t.trimRight(",").trimLeft("(").trimRight(")").val)
+	}
+	val := norm(toks[i])
+	if i+1 < len(toks) {
+		if second, ok := conceptSecondWords[val]; ok {
+			if ct, ok := second[norm(toks[i+1])]; ok {
+				return ct, 2
+			}
+		}
+	}
+	if ct, ok := conceptMarkers[val]; ok {
+		return ct, 1
+	}
+	return parsed.NoConcept, 0
+}
+
+// conceptYear removes a page suffix from a year token, like "2001:23".
+func conceptYear(t spaceToken) spaceToken {
+	idx := strings.Index(t.val, ":")
+	if idx < 0 {
+		return t
+	}
+	t.end -= utf8.RuneCountInString(t.val[idx:])
+	t.val = t.val[:idx]
+	return t
+}
+
+func joinConceptAuthors(auth []string) string {
+	res := strings.Join(auth, " ")
+	return strings.TrimRight(res, ",")
+}
@@ -3,6 +3,7 @@ package parser
 import (
   "io"
 
+  "github.com/gnames/gnparser/ent/nomcode"
   "github.com/gnames/gnparser/ent/parsed"
   "github.com/gnames/gnparser/io/dict"
   "github.com/gnames/tribool"
@@ -18,9 +19,13 @@ type baseEngine struct {
   surrogate       		*parsed.Annotation
   bacteria        		*tribool.Tribool
   warnings        		map[parsed.Warning]struct{}
+  evidence        		map[parsed.CodeEvidence]struct{}
   tail            		string
+  earliestYear    		int
+  latestYear      		int
   enableCultivars 		bool
   preserveDiaereses 	bool
+  code            		nomcode.Code
 }
 
 // New creates implementation of Parser interface.
@@ -30,6 +35,15 @@ func New() Parser {
   return &p
 }
 
+// NewTest creates implementation of Parser interface for tests. It uses
+// a fixed latest year, so results of parsing do not change with time.
+func NewTest() Parser {
+  p := Engine{}
+  p.latestYear = testLatestYear
+  p.Init()
+  return &p
+}
+
 func (p *Engine) fullReset() {
   p.cardinality = 0
   p.error = nil
@@ -39,7 +53,10 @@ func (p *Engine) fullReset() {
   p.bacteria = nil
   var warnReset map[parsed.Warning]struct{}
   p.warnings = warnReset
+  var evidenceReset map[parsed.CodeEvidence]struct{}
+  p.evidence = evidenceReset
   p.tail = ""
+  p.earliestYear = 0
   p.Reset()
 }
 
@@ -52,6 +69,13 @@ func (p *Engine) addWarn(w parsed.Warning) {
   }
 }
 
+func (p *Engine) addEvidence(e parsed.CodeEvidence) {
+  if p.evidence == nil {
+    p.evidence = make(map[parsed.CodeEvidence]struct{})
+  }
+  p.evidence[e] = struct{}{}
+}
+
 func (p *Engine) isBacteria(gen string) {
   if hom, ok := dict.Dict.Bacteria[gen]; ok {
     if hom {
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"github.com/gnames/gnparser/ent/nomcode"
 	"github.com/gnames/gnparser/ent/parsed"
 )
 
@@ -8,10 +9,12 @@ import (
 // name and creation of the Abstract Syntax Tree of the name-string.
 type Parser interface {
 	// PreprocessAndParse takes a scientific name and returns back Abstract
-	// Syntax Tree of the name-string.
+	// Syntax Tree of the name-string. If the nomenclatural code is known,
+	// it is used to resolve ambiguous cases.
 	PreprocessAndParse(
 		name, version string,
 		keepHTML, capitalize, enableCultivars, preserveDiaereses bool,
+		code nomcode.Code,
 	) ScientificNameNode
 	Debug(name string) []byte
 }
@@ -2,10 +2,12 @@ package parser
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/ent/stemmer"
 	"github.com/gnames/gnparser/ent/str"
+	"github.com/gnames/gnparser/io/dict"
 )
 
 type canonical struct {
@@ -24,7 +26,7 @@ func appendCanonical(c1 *canonical, c2 *canonical, sep string) *canonical {
 // contains the value of the word, its semantic meaning and its
 // position in the string.
 func (sn *scientificNameNode) Words() []parsed.Word {
-	return sn.words()
+	return append(sn.words(), sn.tailWords...)
 }
 
 // Normalized returns a normalized version of a scientific name.
@@ -44,8 +46,12 @@ func (sn *scientificNameNode) Canonical() *parsed.Canonical {
 		return res
 	}
 	c := sn.canonical()
+	stemmed := c.Value
+	if vn, ok := sn.nameData.(*virusNode); !ok || !vn.isLegacy() {
+		stemmed = stemmer.StemCanonical(c.Value)
+	}
 	return &parsed.Canonical{
-		Stemmed: stemmer.StemCanonical(c.Value),
+		Stemmed: stemmed,
 		Simple:  c.Value,
 		Full:    c.ValueRanked,
 	}
@@ -76,6 +82,7 @@ func (sn *scientificNameNode) LastAuthorship(withDetails bool) *parsed.Authorshi
 	if !withDetails {
 		res.Original = nil
 		res.Combination = nil
+		res.AuthorDetails = nil
 	}
 	return res
 }
@@ -274,7 +281,7 @@ func (nh *namedSpeciesHybridNode) details() parsed.Details {
 	g := nh.Genus.Normalized
 	so := parsed.Species{
 		Genus:   g,
-		Species: nh.SpEpithet.value(),
+		Species: nh.SpEpithet.Word.Normalized,
 	}
 	if nh.SpEpithet.Authorship != nil {
 		so.Authorship = nh.SpEpithet.Authorship.details()
@@ -499,7 +506,7 @@ func (comp *comparisonNode) details() parsed.Details {
 		return parsed.DetailsComparison{Comparison: co}
 	}
 
-	co.Species = comp.SpEpithet.value()
+	co.Species = comp.SpEpithet.Word.Normalized
 	if comp.SpEpithet.Authorship != nil {
 		co.SpeciesAuthorship = comp.SpEpithet.Authorship.details()
 	}
@@ -674,9 +681,10 @@ func (inf *infraspEpithetNode) details() parsed.InfraspeciesElem {
 		rank = inf.Rank.Word.Normalized
 	}
 	res := parsed.InfraspeciesElem{
-		Value:      inf.Word.Normalized,
-		Rank:       rank,
-		Authorship: inf.Authorship.details(),
+		Value:          inf.Word.Normalized,
+		Rank:           rank,
+		RankNormalized: parsed.NewRank(rank),
+		Authorship:     inf.Authorship.details(),
 	}
 	return res
 }
@@ -784,9 +792,10 @@ func (u *uninomialComboNode) lastAuthorship() *authorshipNode {
 
 func (u *uninomialComboNode) details() parsed.Details {
 	ud := parsed.Uninomial{
-		Value:  u.Uninomial2.Word.Normalized,
-		Rank:   u.Rank.Word.Normalized,
-		Parent: u.Uninomial1.Word.Normalized,
+		Value:          u.Uninomial2.Word.Normalized,
+		Rank:           u.Rank.Word.Normalized,
+		RankNormalized: parsed.NewRank(u.Rank.Word.Normalized),
+		Parent:         u.Uninomial1.Word.Normalized,
 	}
 	if u.Uninomial2.Authorship != nil {
 		ud.Authorship = u.Uninomial2.Authorship.details()
@@ -821,19 +830,25 @@ func (au *authorshipNode) details() *parsed.Authorship {
 		}
 	}
 	var aus []string
+	var ads []parsed.Author
 	if ao.Original != nil {
 		aus = ao.Original.Authors
+		ads = ao.Original.AuthorDetails
 		if ao.Original.ExAuthors != nil {
 			aus = append(aus, ao.Original.ExAuthors.Authors...)
+			ads = append(ads, ao.Original.ExAuthors.AuthorDetails...)
 		}
 	}
 	if ao.Combination != nil {
 		aus = append(aus, ao.Combination.Authors...)
+		ads = append(ads, ao.Combination.AuthorDetails...)
 		if ao.Combination.ExAuthors != nil {
 			aus = append(aus, ao.Combination.ExAuthors.Authors...)
+			ads = append(ads, ao.Combination.ExAuthors.AuthorDetails...)
 		}
 	}
 	ao.Authors = str.Uniq(aus)
+	ao.AuthorDetails = uniqAuthors(ads)
 	ao.Year = yr
 	return &ao
 }
@@ -843,26 +858,29 @@ func authGroupDetail(ag *authorsGroupNode) *parsed.AuthGroup {
 	if ag == nil {
 		return &ago
 	}
-	aus, yr := ag.Team1.details()
+	aus, ads, yr := ag.Team1.details()
 	ago = parsed.AuthGroup{
-		Authors: aus,
-		Year:    yr,
+		Authors:       aus,
+		AuthorDetails: ads,
+		Year:          yr,
 	}
 	if ag.Team2 == nil {
 		return &ago
 	}
-	aus, yr = ag.Team2.details()
+	aus, ads, yr = ag.Team2.details()
 	switch ag.Team2Type {
 	case teamEx:
 		eao := parsed.Authors{
-			Authors: aus,
-			Year:    yr,
+			Authors:       aus,
+			AuthorDetails: ads,
+			Year:          yr,
 		}
 		ago.ExAuthors = &eao
 	case teamEmend:
 		eao := parsed.Authors{
-			Authors: aus,
-			Year:    yr,
+			Authors:       aus,
+			AuthorDetails: ads,
+			Year:          yr,
 		}
 		ago.EmendAuthors = &eao
 	}
@@ -942,24 +960,92 @@ func (aut *authorsTeamNode) value() string {
 	return value
 }
 
-func (at *authorsTeamNode) details() ([]string, *parsed.Year) {
+func (at *authorsTeamNode) details() (
+	[]string,
+	[]parsed.Author,
+	*parsed.Year,
+) {
 	var yr *parsed.Year
 	var aus []string
+	var ads []parsed.Author
 	if at == nil {
-		return aus, yr
+		return aus, ads, yr
 	}
 	aus = make([]string, len(at.Authors))
+	ads = make([]parsed.Author, len(at.Authors))
 	for i, v := range at.Authors {
 		aus[i] = v.Value
+		ads[i] = v.details()
 	}
 	if at.Year == nil {
-		return aus, yr
+		return aus, ads, yr
 	}
 	yr = &parsed.Year{
 		Value:         at.Year.Word.Normalized,
 		IsApproximate: at.Year.Approximate,
+		Verbatim:      at.Year.Verbatim,
+		Start:         at.Year.Start,
+		End:           at.Year.End,
+	}
+	return aus, ads, yr
+}
+
+// details returns the author together with its standard form from the
+// dictionary of author abbreviations.
+func (aun *authorNode) details() parsed.Author {
+	std := aun.standard()
+	return parsed.Author{
+		Value:     aun.Value,
+		Initials:  strings.Join(aun.Initials, " "),
+		Particles: strings.Join(aun.Particles, " "),
+		Surname:   aun.Surname,
+		Filius:    aun.Filius,
+		Suffix:    aun.Suffix,
+		Standard:  std,
+		Key:       dict.AuthorKey(std),
+	}
+}
+
+// standard returns the standard form of the author from the dictionary,
+// or the author's value if it is not in the dictionary. If a filius is
+// not found, the author without filius is looked up, and filius is added
+// back to its standard form ("J. D. Hook. f." is "Hook.f.").
+func (aun *authorNode) standard() string {
+	if std, ok := dict.Dict.StandardAuthor(aun.Value); ok {
+		return std
+	}
+	if !aun.Filius {
+		return aun.Value
+	}
+	std, ok := dict.Dict.StandardAuthor(strings.TrimSuffix(aun.Value, " fil."))
+	if !ok {
+		return aun.Value
+	}
+	if strings.HasSuffix(std, "f.") {
+		return std
 	}
-	return aus, yr
+	fil := std + "f."
+	if !strings.HasSuffix(std, ".") {
+		fil = std + " f."
+	}
+	if res, ok := dict.Dict.StandardAuthor(fil); ok {
+		return res
+	}
+	return fil
+}
+
+// uniqAuthors removes authors with repeated values.
+func uniqAuthors(ads []parsed.Author) []parsed.Author {
+	var res []parsed.Author
+	seen := make(map[string]struct{}, len(ads))
+	for i := range ads {
+		if _, ok := seen[ads[i].Value]; ok {
+			continue
+		}
+		seen[ads[i].Value] = struct{}{}
+		res = append(res, ads[i])
+	}
+	return res
 }
 
 func (aut *authorsTeamNode) words() []parsed.Word {
@@ -0,0 +1,125 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// nomStatuses is a controlled vocabulary of nomenclatural statuses. Keys
+// are made from one or two words of a status, in lower case, without
+// dots and spaces.
+var nomStatuses = map[string]parsed.NomStatus{
+	"nomnud":            parsed.NomenNudumStatus,
+	"nomennudum":        parsed.NomenNudumStatus,
+	"nomilleg":          parsed.NomenIllegitimumStatus,
+	"nomenillegitimum":  parsed.NomenIllegitimumStatus,
+	"nominval":          parsed.NomenInvalidumStatus,
+	"nomeninvalidum":    parsed.NomenInvalidumStatus,
+	"nomcons":           parsed.NomenConservandumStatus,
+	"nomenconservandum": parsed.NomenConservandumStatus,
+	"nomrej":            parsed.NomenRejiciendumStatus,
+	"nomenrejiciendum":  parsed.NomenRejiciendumStatus,
+	"nomdub":            parsed.NomenDubiumStatus,
+	"nomendubium":       parsed.NomenDubiumStatus,
+	"nomobl":            parsed.NomenOblitumStatus,
+	"nomenoblitum":      parsed.NomenOblitumStatus,
+	"nomprot":           parsed.NomenProtectumStatus,
+	"nomenprotectum":    parsed.NomenProtectumStatus,
+	"nomsuperfl":        parsed.NomenSuperfluumStatus,
+	"nomensuperfluum":   parsed.NomenSuperfluumStatus,
+	"nomnov":            parsed.NomenNovumStatus,
+	"nomennovum":        parsed.NomenNovumStatus,
+	"nomambig":          parsed.NomenAmbiguumStatus,
+	"nomenambiguum":     parsed.NomenAmbiguumStatus,
+	"nomprov":           parsed.NomenProvisoriumStatus,
+	"nomprovis":         parsed.NomenProvisoriumStatus,
+	"nomenprovisorium":  parsed.NomenProvisoriumStatus,
+	"combnov":           parsed.CombinatioNovaStatus,
+	"combinationova":    parsed.CombinatioNovaStatus,
+	"statnov":           parsed.StatusNovusStatus,
+	"statusnovus":       parsed.StatusNovusStatus,
+	"gennov":            parsed.GenusNovumStatus,
+	"genusnovum":        parsed.GenusNovumStatus,
+	"spnov":             parsed.SpeciesNovaStatus,
+	"specnov":           parsed.SpeciesNovaStatus,
+	"speciesnova":       parsed.SpeciesNovaStatus,
+	"subspnov":          parsed.SubspeciesNovaStatus,
+	"sspnov":            parsed.SubspeciesNovaStatus,
+	"varnov":            parsed.VarietasNovaStatus,
+	"ined":              parsed.IneditusStatus,
+	"orthvar":           parsed.OrthographicVariantStatus,
+}
+
+// addNomStatus finds nomenclatural statuses at the start of the tail.
+// The full is the whole preprocessed name-string, it is used to find
+// positions of the status words. Recognized part of the tail is removed
+// from it.
+func (sn *scientificNameNode) addNomStatus(full string) {
+	tail, offset, ok := sn.tailOffset(full)
+	if !ok {
+		return
+	}
+
+	toks := spaceTokens(tail)
+	var ws []parsed.Word
+	var i, end int
+	for i < len(toks) {
+		if trimStatusToken(toks[i]).val == "" {
+			i++
+			continue
+		}
+		st, n := nomStatus(toks, i)
+		if n == 0 {
+			break
+		}
+		var verbatim []string
+		for j := i; j < i+n; j++ {
+			t := trimStatusToken(toks[j])
+			verbatim = append(verbatim, t.val)
+			w := t.word(parsed.NomStatusType)
+			w.Start += offset
+			w.End += offset
+			ws = append(ws, w)
+		}
+		sn.nomStatus = append(sn.nomStatus, parsed.NomenclaturalStatus{
+			Verbatim: strings.Join(verbatim, " "),
+			Status:   st,
+		})
+		i += n
+		end = toks[i-1].bytes
+	}
+
+	if end == 0 {
+		return
+	}
+	sn.tailWords = append(sn.tailWords, ws...)
+	sn.tail = strings.TrimRight(tail[end:], " ")
+}
+
+// nomStatus checks if there is a status at the i position. It returns
+// the status and the number of its tokens.
+func nomStatus(toks []spaceToken, i int) (parsed.NomStatus, int) {
+	key := func(ts []spaceToken) string {
+		var res string
+		for _, v := range ts {
+			res += strings.ReplaceAll(trimStatusToken(v).val, ".", "")
+		}
+		return strings.ToLower(res)
+	}
+	if i+1 < len(toks) {
+		if st, ok := nomStatuses[key(toks[i:i+2])]; ok {
+			return st, 2
+		}
+	}
+	if st, ok := nomStatuses[key(toks[i:i+1])]; ok {
+		return st, 1
+	}
+	return parsed.NoNomStatus, 0
+}
+
+// trimStatusToken removes punctuation around a status word.
+func trimStatusToken(t spaceToken) spaceToken {
+	return t.trimLeft(",").trimRight(",").trimRight(";").trimLeft("(").
+		trimRight(")")
+}
@@ -4,6 +4,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/gnames/gnparser/ent/nomcode"
 	"github.com/gnames/gnparser/ent/parsed"
 )
 
@@ -13,17 +14,30 @@ func (sn *scientificNameNode) ToOutput(withDetails bool) parsed.Parsed {
 	res := parsed.Parsed{
 		Verbatim:      sn.verbatim,
 		Canonical:     sn.Canonical(),
+		Code:          sn.code,
 		Virus:         sn.virus,
+		VirusCategory: sn.virusCategory,
 		DaggerChar:    sn.daggerChar,
 		VerbatimID:    sn.verbatimID,
 		ParserVersion: sn.parserVersion,
 	}
 
 	if res.Canonical == nil {
+		if sn.virusCategory.IsVirus() {
+			res.InferredCode = parsed.NewCodeInference(
+				[]parsed.CodeEvidence{parsed.VirusEvidence},
+			)
+		}
 		return res
 	}
 
 	res.Parsed = true
+	res.InferredCode = sn.inferCode()
+	code := rankCode(sn.code, res.InferredCode)
+	// rank and year checks can add warnings, so they go before quality
+	// warnings.
+	res.Rank = sn.rank(code)
+	sn.checkEarliestYear(code)
 	res.ParseQuality, res.QualityWarnings = sn.qualityWarnings()
 	res.Normalized = sn.Normalized()
 	res.Cardinality = sn.cardinality
@@ -31,9 +45,14 @@ func (sn *scientificNameNode) ToOutput(withDetails bool) parsed.Parsed {
 	res.Hybrid = sn.hybrid
 	res.Surrogate = sn.surrogate
 	res.Bacteria = sn.bacteria
+	res.Strain = sn.strain
+	res.TaxonConcept = sn.taxonConcept
+	res.NomenclaturalStatus = sn.nomStatus
 	res.Tail = sn.tail
 	if withDetails {
 		res.Details = sn.Details()
+		res.Details = inferDetailsRanks(res.Details, code)
+		res.Parents = sn.parents(code)
 		res.Words = sn.Words()
 	}
 
@@ -44,7 +63,7 @@ func (sn *scientificNameNode) ToOutput(withDetails bool) parsed.Parsed {
 }
 
 func (sn *scientificNameNode) qualityWarnings() (int, []parsed.QualityWarning) {
-	if sn.cardinality > 2 && sn.maybeFilius() {
+	if sn.cardinality > 2 && sn.filiusUnresolved() && sn.maybeFilius() {
 		if sn.warnings == nil {
 			sn.warnings = make(map[parsed.Warning]struct{})
 		}
@@ -59,6 +78,86 @@ func (sn *scientificNameNode) qualityWarnings() (int, []parsed.QualityWarning) {
 	return quality, warns
 }
 
+// inferCode collects evidence of a nomenclatural code from the name
+// and uses it to infer the code.
+func (sn *scientificNameNode) inferCode() *parsed.CodeInference {
+	ev := make(map[parsed.CodeEvidence]struct{})
+	for k := range sn.evidence {
+		ev[k] = struct{}{}
+	}
+	if _, ok := sn.warnings[parsed.BotanyAuthorNotSubgenWarn]; ok {
+		ev[parsed.GenusAuthorEvidence] = struct{}{}
+	}
+	if sn.bacteria != nil {
+		if sn.bacteria.Value == 1 {
+			ev[parsed.BacterialGenusEvidence] = struct{}{}
+		} else {
+			ev[parsed.BacterialHomonymEvidence] = struct{}{}
+		}
+	}
+	if sn.hybrid != nil {
+		switch *sn.hybrid {
+		case parsed.GraftChimeraFormulaAnnot, parsed.NamedGraftChimeraAnnot:
+			ev[parsed.GraftChimeraEvidence] = struct{}{}
+		default:
+			ev[parsed.HybridEvidence] = struct{}{}
+		}
+	}
+
+	for _, v := range sn.Words() {
+		switch v.Type {
+		case parsed.RankType:
+			if e := rankEvidence(v.Normalized); e != parsed.NoEvidence {
+				ev[e] = struct{}{}
+			}
+		case parsed.YearType, parsed.YearApproximateType:
+			ev[parsed.YearEvidence] = struct{}{}
+		case parsed.SubgenusType:
+			ev[parsed.SubgenusEvidence] = struct{}{}
+		case parsed.CultivarType:
+			ev[parsed.CultivarEvidence] = struct{}{}
+		case parsed.CandidatusType:
+			ev[parsed.CandidatusEvidence] = struct{}{}
+		}
+	}
+
+	res := make([]parsed.CodeEvidence, 0, len(ev))
+	for k := range ev {
+		res = append(res, k)
+	}
+	return parsed.NewCodeInference(res)
+}
+
+var botanicalRanks = map[string]struct{}{
+	"var": {}, "f": {}, "convar": {}, "subvar": {}, "subf": {}, "sect": {},
+	"subsect": {}, "ser": {}, "subser": {}, "agamosp": {}, "agamossp": {},
+	"agamovar": {}, "nvar": {}, "cv": {},
+}
+
+var bacterialRanks = map[string]struct{}{"pv": {}, "pathovar": {}}
+
+func rankEvidence(rank string) parsed.CodeEvidence {
+	r := strings.TrimSuffix(strings.ToLower(rank), ".")
+	if _, ok := botanicalRanks[r]; ok || strings.HasPrefix(r, "notho") {
+		return parsed.BotanicalRankEvidence
+	}
+	if _, ok := bacterialRanks[r]; ok {
+		return parsed.BacterialRankEvidence
+	}
+	return parsed.NoEvidence
+}
+
+// filiusUnresolved returns true if the nomenclatural code does not
+// decide if `f.` is 'filius' or 'forma'.
+func (sn *scientificNameNode) filiusUnresolved() bool {
+	switch sn.code {
+	case nomcode.Zoological, nomcode.Botanical, nomcode.Cultivars:
+		return false
+	default:
+		return true
+	}
+}
+
 func (sn *scientificNameNode) maybeFilius() bool {
 	words := sn.Words()
 	for i := range words {
@@ -0,0 +1,159 @@
+package parser
+
+import (
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/stemmer"
+	"github.com/gnames/gnparser/ent/str"
+)
+
+// parents returns names of higher ranks that are implied by the name,
+// from the highest to the lowest rank. Hybrid and graft-chimera formulas,
+// surrogates and viruses do not have parents.
+func (sn *scientificNameNode) parents(code nomcode.Code) []parsed.Parent {
+	return nameParents(sn.nameData, code)
+}
+
+func nameParents(nd nameData, code nomcode.Code) []parsed.Parent {
+	switch n := nd.(type) {
+	case *uninomialNode:
+		if n.CultivarEpithet == nil || !n.CultivarEpithet.enableCultivars {
+			return nil
+		}
+		return []parsed.Parent{uninomialParent(parsed.GenusRank, n)}
+	case *uninomialComboNode:
+		r := parsed.NoRank
+		if rk := parsed.NewRank(n.Rank.Word.Normalized); rk > parsed.GenusRank &&
+			rk < parsed.SpeciesRank {
+			r = parsed.GenusRank
+		}
+		return []parsed.Parent{uninomialParent(r, n.Uninomial1)}
+	case *speciesNode:
+		withCultivar := n.CultivarEpithet != nil && n.CultivarEpithet.enableCultivars
+		gen := n.Genus.Normalized
+		if n.Subgenus != nil {
+			gen = str.JoinStrings(gen, "("+n.Subgenus.Normalized+")", " ")
+		}
+		c := &canonical{Value: n.Genus.Normalized, ValueRanked: n.Genus.Normalized}
+		ps := speciesParents(c, gen, n.SpEpithet, n.Infraspecies, withCultivar, code)
+		if n.Subgenus == nil {
+			return ps
+		}
+		// the subgenus goes right after the genus.
+		res := []parsed.Parent{ps[0], subgenusParent(n.Genus, n.Subgenus)}
+		return append(res, ps[1:]...)
+	case *namedSpeciesHybridNode:
+		g := n.Genus.Normalized
+		c := &canonical{Value: g, ValueRanked: g}
+		res := []parsed.Parent{newParent(parsed.GenusRank, c, g, nil)}
+		c = appendCanonical(c, &canonical{ValueRanked: "×"}, " ")
+		ps := speciesParents(c, g+" ×", n.SpEpithet, n.Infraspecies, false, code)
+		// the first parent is the genus without the hybrid sign.
+		return append(res, ps[1:]...)
+	case *namedGenusHybridNode:
+		return prefixParents(nameParents(n.nameData, code), "×", "×")
+	case *namedGenusGraftChimeraNode:
+		return prefixParents(nameParents(n.nameData, code), "+", "+")
+	case *candidatusNameNode:
+		return prefixParents(
+			nameParents(n.SingleName, code),
+			n.Candidatus.Normalized,
+			"Candidatus",
+		)
+	}
+	return nil
+}
+
+// speciesParents returns the genus, the species and all infraspecific
+// names above the last one. If the name has a cultivar epithet, the last
+// infraspecific name (or the species) is also a parent.
+func speciesParents(
+	c *canonical,
+	gen string,
+	sp *spEpithetNode,
+	infs []*infraspEpithetNode,
+	withCultivar bool,
+	code nomcode.Code,
+) []parsed.Parent {
+	g := c.Value
+	res := []parsed.Parent{
+		newParent(parsed.GenusRank, &canonical{Value: g, ValueRanked: g}, g, nil),
+	}
+	if len(infs) == 0 && !withCultivar {
+		return res
+	}
+
+	c = appendCanonical(c, sp.canonical(), " ")
+	norm := str.JoinStrings(gen, sp.Word.Normalized, " ")
+	res = append(res, newParent(parsed.SpeciesRank, c, norm, sp.Authorship))
+	norm = str.JoinStrings(norm, sp.Authorship.value(), " ")
+
+	last := len(infs) - 1
+	if withCultivar {
+		last = len(infs)
+	}
+	for i := 0; i < last; i++ {
+		inf := infs[i]
+		if inf == nil {
+			return res
+		}
+		r := unrankedRank(len(infs), code)
+		if inf.Rank != nil && inf.Rank.Word != nil {
+			r = parsed.NewRank(inf.Rank.Word.Normalized)
+		}
+		c = appendCanonical(c, inf.canonical(), " ")
+		norm = str.JoinStrings(norm, inf.canonical().ValueRanked, " ")
+		res = append(res, newParent(r, c, norm, inf.Authorship))
+		norm = str.JoinStrings(norm, inf.Authorship.value(), " ")
+	}
+	return res
+}
+
+// newParent creates a parent from its canonical forms, its normalized name
+// without the authorship, and the authorship.
+func newParent(
+	r parsed.Rank,
+	c *canonical,
+	norm string,
+	au *authorshipNode,
+) parsed.Parent {
+	return parsed.Parent{
+		Rank:       r,
+		Normalized: str.JoinStrings(norm, au.value(), " "),
+		Canonical: &parsed.Canonical{
+			Stemmed: stemmer.StemCanonical(c.Value),
+			Simple:  c.Value,
+			Full:    c.ValueRanked,
+		},
+		Authorship: au.details(),
+	}
+}
+
+// uninomialParent creates a parent from a uninomial without its cultivar
+// epithet.
+func uninomialParent(r parsed.Rank, u *uninomialNode) parsed.Parent {
+	v := u.Word.Normalized
+	return newParent(r, &canonical{Value: v, ValueRanked: v}, v, u.Authorship)
+}
+
+// subgenusParent creates a parent from a subgenus of a species name.
+// Its canonical forms are the same as of a uninomial combination
+// "Aus subgen. Bus".
+func subgenusParent(gen, sg *parsed.Word) parsed.Parent {
+	c := &canonical{
+		Value:       sg.Normalized,
+		ValueRanked: gen.Normalized + " subgen. " + sg.Normalized,
+	}
+	norm := gen.Normalized + " (" + sg.Normalized + ")"
+	return newParent(parsed.SubgenusRank, c, norm, nil)
+}
+
+// prefixParents adds a prefix, for example a hybrid sign, to normalized
+// and full canonical forms of parents.
+func prefixParents(ps []parsed.Parent, norm, full string) []parsed.Parent {
+	for i := range ps {
+		ps[i].Normalized = str.JoinStrings(norm, ps[i].Normalized, " ")
+		ps[i].Canonical.Full = str.JoinStrings(full, ps[i].Canonical.Full, " ")
+	}
+	return ps
+}
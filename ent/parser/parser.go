@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/gnames/gnparser/ent/internal/preprocess"
+	"github.com/gnames/gnparser/ent/nomcode"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/ent/str"
 )
@@ -42,10 +43,12 @@ func (p *Engine) PreprocessAndParse(
 	capitalize bool,
 	enableCultivars bool,
 	preserveDiaereses bool,
+	code nomcode.Code,
 ) ScientificNameNode {
 
-	p.enableCultivars = enableCultivars
+	p.enableCultivars = enableCultivars || code == nomcode.Cultivars
 	p.preserveDiaereses = preserveDiaereses
+	p.code = code
 
 	originalString := s
 	var tagsOrEntities, lowCase bool
@@ -67,9 +70,19 @@ func (p *Engine) PreprocessAndParse(
 
 	defer func() {
 		p.sn.daggerChar = preproc.DaggerChar
+		p.sn.virusCategory = preproc.VirusCategory
 		if len(preproc.Tail) > 0 {
 			p.sn.tail += string(preproc.Tail)
 		}
+		p.sn.addStrain(code)
+		full := string(preproc.Body) + string(preproc.Tail)
+		// Statuses and concepts are found only at the start of the tail.
+		// A status might go before a concept ("nom. illeg. sensu Smith"),
+		// or after it ("sensu Smith nom. nud."), so statuses are searched
+		// on both sides of the concept.
+		p.sn.addNomStatus(full)
+		p.sn.addTaxonConcept(full)
+		p.sn.addNomStatus(full)
 		if len(p.sn.tail) > 0 {
 			p.addWarn(parsed.TailWarn)
 			if str.IsBoldSurrogate(p.sn.tail) {
@@ -85,23 +98,21 @@ func (p *Engine) PreprocessAndParse(
 		p.sn.warnings = p.warnings
 		p.sn.addVerbatim(originalString)
 		p.sn.parserVersion = ver
+		p.sn.code = code
 	}()
 
 	if preproc.NoParse {
+		if preproc.Virus && p.parseVirus(s) {
+			p.addInputWarns(tagsOrEntities, lowCase)
+			return p.sn
+		}
 		p.newNotParsedScientificNameNode(preproc)
 		return p.sn
 	}
 
 	p.Buffer = string(preproc.Body)
 	p.fullReset()
-
-	if tagsOrEntities {
-		p.addWarn(parsed.HTMLTagsEntitiesWarn)
-	}
-
-	if lowCase {
-		p.addWarn(parsed.LowCaseWarn)
-	}
+	p.addInputWarns(tagsOrEntities, lowCase)
 
 	if preproc.Underscore {
 		p.addWarn(parsed.SpaceNonStandardWarn)
@@ -118,3 +129,15 @@ func (p *Engine) PreprocessAndParse(
 	p.newScientificNameNode()
 	return p.sn
 }
+
+// addInputWarns adds warnings about changes made to the input string
+// before parsing.
+func (p *Engine) addInputWarns(tagsOrEntities, lowCase bool) {
+	if tagsOrEntities {
+		p.addWarn(parsed.HTMLTagsEntitiesWarn)
+	}
+
+	if lowCase {
+		p.addWarn(parsed.LowCaseWarn)
+	}
+}
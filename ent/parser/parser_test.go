@@ -1,8 +1,12 @@
 package parser_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/ent/parser"
 	"github.com/stretchr/testify/assert"
 )
@@ -18,7 +22,9 @@ func TestPreNParse(t *testing.T) {
 		{"something", ""},
 	}
 	for _, v := range testData {
-		sn := p.PreprocessAndParse(v.name, "test_version", true, false, false, false)
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, nomcode.Unknown,
+		)
 		parsed := sn.ToOutput(false)
 		can := parsed.Canonical
 		msg := v.name
@@ -54,7 +60,9 @@ func TestToOutput(t *testing.T) {
 		{"something", "", "", false, false},
 	}
 	for _, v := range testData {
-		sn := p.PreprocessAndParse(v.name, "test_version", true, false, false, false)
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, nomcode.Unknown,
+		)
 		out := sn.ToOutput(v.det)
 		msg := v.name
 		if !out.Parsed {
@@ -65,3 +73,631 @@ func TestToOutput(t *testing.T) {
 		assert.Equal(t, out.Authorship.Normalized, v.au, msg)
 	}
 }
+
+// TestSpeciesDetails tests that specific epithets in details do not
+// include authorship.
+func TestSpeciesDetails(t *testing.T) {
+	p := &parser.Engine{Buffer: ""}
+	p.Init()
+	testData := []struct {
+		name, sp, au string
+	}{
+		{"Aus cf. bus L.", "bus", "L."},
+		{"Aus × bus L.", "bus", "L."},
+		{"Aus bus L.", "bus", "L."},
+	}
+	for _, v := range testData {
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, nomcode.Unknown,
+		)
+		out := sn.ToOutput(true)
+		var sp string
+		var au *parsed.Authorship
+		switch d := out.Details.(type) {
+		case parsed.DetailsComparison:
+			sp, au = d.Comparison.Species, d.Comparison.SpeciesAuthorship
+		case parsed.DetailsSpecies:
+			sp, au = d.Species.Species, d.Species.Authorship
+		}
+		assert.Equal(t, v.sp, sp, v.name)
+		if assert.NotNil(t, au, v.name) {
+			assert.Equal(t, v.au, au.Normalized, v.name)
+		}
+	}
+}
+
+// TestCode tests how nomenclatural code resolves ambiguities.
+func TestCode(t *testing.T) {
+	p := &parser.Engine{Buffer: ""}
+	p.Init()
+	testData := []struct {
+		msg, name, norm, can string
+		code                 nomcode.Code
+		warns                []string
+	}{
+		{"filius auto", "Aus bus L. f. cus", "Aus bus L. f. cus",
+			"Aus bus f. cus", nomcode.Unknown,
+			[]string{"Ambiguous f. (filius or forma)"}},
+		{"filius bot", "Aus bus L. f. cus", "Aus bus L. f. cus",
+			"Aus bus f. cus", nomcode.Botanical, nil},
+		{"filius zoo", "Aus bus L. f. cus", "Aus bus L. fil. cus",
+			"Aus bus cus", nomcode.Zoological, nil},
+		{"filius no space auto", "Aus bus L.f. cus", "Aus bus L. fil. cus",
+			"Aus bus cus", nomcode.Unknown,
+			[]string{"Ambiguous f. (filius or forma)"}},
+		{"filius no space bot", "Aus bus L.f. cus", "Aus bus L. f. cus",
+			"Aus bus f. cus", nomcode.Botanical, nil},
+		{"filius no space cult", "Aus bus L.f. cus", "Aus bus L. f. cus",
+			"Aus bus f. cus", nomcode.Cultivars, nil},
+		{"filius full bot", "Aus bus L. fil. cus", "Aus bus L. fil. cus",
+			"Aus bus cus", nomcode.Botanical, nil},
+		{"filius infrasp zoo", "Aus bus cus L. f. dus",
+			"Aus bus cus L. fil. dus", "Aus bus cus dus", nomcode.Zoological,
+			nil},
+		{"subgen auto", "Aus (Urban) bus", "Aus bus", "Aus bus",
+			nomcode.Unknown, []string{"Possible ICN author instead of subgenus"}},
+		{"subgen zoo", "Aus (Urban) bus", "Aus (Urban) bus", "Aus bus",
+			nomcode.Zoological, nil},
+		{"uninomial auto", "Humiriastrum (Urban) Cuatrecasas, 1961",
+			"Humiriastrum (Urban) Cuatrecasas 1961", "Humiriastrum",
+			nomcode.Unknown, []string{"Possible ICN author instead of subgenus"}},
+		{"uninomial zoo", "Humiriastrum (Urban) Cuatrecasas, 1961",
+			"Humiriastrum subgen. Urban Cuatrecasas 1961", "Humiriastrum subgen. Urban",
+			nomcode.Zoological, []string{"Combination of two uninomials"}},
+		{"ex auto", "Aus bus L. ex Mill.", "Aus bus L. ex Mill.", "Aus bus",
+			nomcode.Unknown, []string{"Ex authors are not required (ICZN only)"}},
+		{"ex bot", "Aus bus L. ex Mill.", "Aus bus L. ex Mill.", "Aus bus",
+			nomcode.Botanical, nil},
+		{"ex zoo", "Aus bus L. ex Mill.", "Aus bus L. ex Mill.", "Aus bus",
+			nomcode.Zoological, []string{"Ex authors are not required (ICZN only)"}},
+	}
+	for _, v := range testData {
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, v.code,
+		)
+		out := sn.ToOutput(true)
+		assert.Equal(t, out.Normalized, v.norm, v.msg)
+		assert.Equal(t, out.Canonical.Full, v.can, v.msg)
+		assert.Equal(t, out.Code, v.code, v.msg)
+		var warns []string
+		for _, w := range out.QualityWarnings {
+			warns = append(warns, w.Warning.String())
+		}
+		assert.Equal(t, warns, v.warns, v.msg)
+	}
+}
+
+// TestInferCode tests inference of nomenclatural code from a name.
+func TestInferCode(t *testing.T) {
+	p := &parser.Engine{Buffer: ""}
+	p.Init()
+	testData := []struct {
+		msg, name string
+		code      nomcode.Code
+		evidence  []parsed.CodeEvidence
+	}{
+		{"no evidence", "Bubo bubo", nomcode.Unknown, nil},
+		{"rank", "Aus bus L. var. cus", nomcode.Botanical,
+			[]parsed.CodeEvidence{parsed.BotanicalRankEvidence}},
+		{"comb", "Aus bus (L.) Mill. ex Smith", nomcode.Botanical,
+			[]parsed.CodeEvidence{
+				parsed.CombinationAuthorsEvidence, parsed.ExAuthorsEvidence,
+			}},
+		{"zoo", "Aus (Bus) cus Smith, 1900", nomcode.Zoological,
+			[]parsed.CodeEvidence{parsed.YearEvidence, parsed.SubgenusEvidence}},
+		{"cultivar", "Sarracenia flava 'Maxima'", nomcode.Cultivars,
+			[]parsed.CodeEvidence{parsed.CultivarEvidence}},
+		{"candidatus", "Candidatus Phytoplasma allocasuarinae",
+			nomcode.Bacterial, []parsed.CodeEvidence{
+				parsed.CandidatusEvidence, parsed.BacterialGenusEvidence,
+			}},
+		{"virus", "Cytospora ribis mitovirus 2", nomcode.Virus,
+			[]parsed.CodeEvidence{parsed.VirusEvidence}},
+		{"hybrid", "Aus × Bus", nomcode.Botanical,
+			[]parsed.CodeEvidence{parsed.HybridEvidence}},
+	}
+	for _, v := range testData {
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, nomcode.Unknown,
+		)
+		out := sn.ToOutput(false)
+		if v.evidence == nil {
+			assert.Nil(t, out.InferredCode, v.msg)
+			continue
+		}
+		assert.Equal(t, out.InferredCode.Code, v.code, v.msg)
+		assert.Equal(t, out.InferredCode.Evidence, v.evidence, v.msg)
+	}
+}
+
+func TestVirus(t *testing.T) {
+	p := &parser.Engine{Buffer: ""}
+	p.Init()
+	testData := []struct {
+		msg, name, canonical, tail string
+		card                       int
+		details                    parsed.Virus
+	}{
+		{"binomial", "Betacoronavirus pandemicum", "Betacoronavirus pandemicum",
+			"", 2, parsed.Virus{
+				Genus: "Betacoronavirus", Species: "pandemicum",
+				Name: "Betacoronavirus pandemicum",
+			}},
+		{"binomial strain", "Betacoronavirus pandemicum SARS-CoV-2",
+			"Betacoronavirus pandemicum", "", 2, parsed.Virus{
+				Genus: "Betacoronavirus", Species: "pandemicum",
+				Name: "Betacoronavirus pandemicum", Strain: "SARS-CoV-2",
+			}},
+		{"genus", "Arv1virus", "Arv1virus", "", 1,
+			parsed.Virus{Genus: "Arv1virus", Name: "Arv1virus"}},
+		{"legacy", "Tobacco mosaic virus (TMV)", "Tobacco mosaic virus", "", 0,
+			parsed.Virus{Name: "Tobacco mosaic virus", Acronym: "TMV"}},
+		{"isolate", "Severe acute respiratory syndrome-related coronavirus " +
+			"isolate Wuhan-Hu-1",
+			"Severe acute respiratory syndrome-related coronavirus Wuhan-Hu-1",
+			"", 0,
+			parsed.Virus{
+				Name:   "Severe acute respiratory syndrome-related coronavirus",
+				Strain: "Wuhan-Hu-1",
+			}},
+		{"strain parens", "Rachiplusia ou virus (strain R1)",
+			"Rachiplusia ou virus R1", "", 0,
+			parsed.Virus{Name: "Rachiplusia ou virus", Strain: "R1"}},
+		{"numbered", "Human immunodeficiency virus 1",
+			"Human immunodeficiency virus 1", "", 0,
+			parsed.Virus{Name: "Human immunodeficiency virus 1"}},
+		{"roman numeral", "Human immunodeficiency virus II (HIV-2)",
+			"Human immunodeficiency virus II", "", 0,
+			parsed.Virus{Name: "Human immunodeficiency virus II", Acronym: "HIV-2"}},
+		{"numbered strain", "Human immunodeficiency virus 2 isolate ST",
+			"Human immunodeficiency virus 2 ST", "", 0,
+			parsed.Virus{Name: "Human immunodeficiency virus 2", Strain: "ST"}},
+		{"phage", "Aeromonas phage 65", "Aeromonas phage 65", "", 0,
+			parsed.Virus{Name: "Aeromonas phage", Strain: "65"}},
+		{"phage T4", "Escherichia phage T4", "Escherichia phage T4", "", 0,
+			parsed.Virus{Name: "Escherichia phage", Strain: "T4"}},
+		{"phage T7", "Escherichia phage T7", "Escherichia phage T7", "", 0,
+			parsed.Virus{Name: "Escherichia phage", Strain: "T7"}},
+		{"tail", "Acute bee paralysis virus [AF150629] Acute bee paralysis virus",
+			"Acute bee paralysis virus",
+			" [AF150629] Acute bee paralysis virus", 0,
+			parsed.Virus{Name: "Acute bee paralysis virus"}},
+	}
+	for _, v := range testData {
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, nomcode.Unknown,
+		)
+		out := sn.ToOutput(true)
+		assert.True(t, out.Parsed, v.msg)
+		assert.True(t, out.Virus, v.msg)
+		assert.Equal(t, out.Canonical.Simple, v.canonical, v.msg)
+		assert.Equal(t, out.Cardinality, v.card, v.msg)
+		assert.Equal(t, out.Tail, v.tail, v.msg)
+		assert.Equal(t, out.Details, parsed.DetailsVirus{Virus: v.details}, v.msg)
+	}
+
+	sn := p.PreprocessAndParse(
+		"Tobacco mosaic virus (TMV)", "test_version", true, false, false, false,
+		nomcode.Unknown,
+	)
+	out := sn.ToOutput(true)
+	assert.Equal(t, out.Canonical.Stemmed, "Tobacco mosaic virus")
+	assert.Equal(t, len(out.Words), 4)
+	assert.Equal(t, out.Words[3].Type, parsed.VirusAcronymType)
+	assert.Equal(t, out.Words[3].Start, 22)
+	assert.Equal(t, out.Words[3].End, 25)
+
+	sn = p.PreprocessAndParse(
+		"Tobacco mosaic virus strain U1 (TMV)", "test_version", true, false,
+		false, false, nomcode.Unknown,
+	)
+	out = sn.ToOutput(false)
+	assert.Equal(t, out.Normalized, "Tobacco mosaic virus strain U1 (TMV)")
+	assert.Equal(t, out.Canonical.Full, "Tobacco mosaic virus U1")
+
+	sn = p.PreprocessAndParse(
+		"Cre expression vector", "test_version", true, false, false, false,
+		nomcode.Unknown,
+	)
+	out = sn.ToOutput(true)
+	assert.False(t, out.Parsed)
+	assert.True(t, out.Virus)
+}
+
+func TestStrain(t *testing.T) {
+	p := &parser.Engine{Buffer: ""}
+	p.Init()
+	testData := []struct {
+		msg, name, tail string
+		code            nomcode.Code
+		strain          *parsed.Strain
+	}{
+		{"no strain", "Escherichia coli", "", nomcode.Unknown, nil},
+		{"not bacteria", "Homo sapiens K-12", " K-12", nomcode.Unknown, nil},
+		{"designation", "Escherichia coli K-12", "", nomcode.Unknown,
+			&parsed.Strain{Verbatim: "K-12", Designation: "K-12"}},
+		{"serovar", "Escherichia coli O157:H7 str. Sakai", "", nomcode.Unknown,
+			&parsed.Strain{
+				Verbatim:    "O157:H7 str. Sakai",
+				Designation: "Sakai",
+				Serovar:     "O157:H7",
+			}},
+		{"pathovar", "Pseudomonas syringae pv. tomato str. DC3000", "",
+			nomcode.Unknown, &parsed.Strain{
+				Verbatim:    "str. DC3000",
+				Designation: "DC3000",
+				Pathovar:    "tomato",
+			}},
+		{"biovar", "Rhizobium leguminosarum bv. viciae", "", nomcode.Unknown,
+			&parsed.Strain{Verbatim: "bv. viciae", Biovar: "viciae"}},
+		{"accessions", "Bacillus subtilis DSM 10 = ATCC 6051", "",
+			nomcode.Unknown, &parsed.Strain{
+				Verbatim:   "DSM 10 = ATCC 6051",
+				Accessions: []string{"DSM 10", "ATCC 6051"},
+			}},
+		{"approximation", "Bacillus sp. ATCC 12345", "", nomcode.Unknown,
+			&parsed.Strain{
+				Verbatim:   "ATCC 12345",
+				Accessions: []string{"ATCC 12345"},
+			}},
+		{"rest", "Escherichia coli NCTC 9001 extra words", " extra words",
+			nomcode.Unknown, &parsed.Strain{
+				Verbatim:   "NCTC 9001",
+				Accessions: []string{"NCTC 9001"},
+			}},
+		{"code", "Aus bus str. A1", "", nomcode.Bacterial,
+			&parsed.Strain{Verbatim: "str. A1", Designation: "A1"}},
+	}
+	for _, v := range testData {
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, v.code,
+		)
+		out := sn.ToOutput(false)
+		assert.Equal(t, out.Strain, v.strain, v.msg)
+		assert.Equal(t, out.Tail, v.tail, v.msg)
+		if v.tail == "" {
+			for _, w := range out.QualityWarnings {
+				assert.NotEqual(t, w.Warning, parsed.TailWarn, v.msg)
+			}
+		}
+	}
+}
+
+func TestTaxonConcept(t *testing.T) {
+	p := &parser.Engine{Buffer: ""}
+	p.Init()
+	testData := []struct {
+		msg, name, tail string
+		concept         *parsed.TaxonConcept
+	}{
+		{"no concept", "Aus bus L.", "", nil},
+		{"sensu", "Aus bus sensu Smith, 1900", "", &parsed.TaxonConcept{
+			Verbatim: "sensu Smith, 1900", Type: parsed.SensuConcept,
+			According: "Smith, 1900",
+		}},
+		{"auct", "Aus bus auct. non L.", "", &parsed.TaxonConcept{
+			Verbatim: "auct. non L.", Type: parsed.AuctorumConcept, Non: "L.",
+		}},
+		{"sensu auct", "Aus bus sensu auct., non (Blanco) Kosterm.", "",
+			&parsed.TaxonConcept{
+				Verbatim: "sensu auct., non (Blanco) Kosterm.",
+				Type:     parsed.AuctorumConcept, Non: "(Blanco) Kosterm.",
+			}},
+		{"s.l.", "Aus bus Smith s. l.", "", &parsed.TaxonConcept{
+			Verbatim: "s. l.", Type: parsed.SensuLatoConcept,
+		}},
+		{"s.str.", "Aus bus L. (s.str.)", "", &parsed.TaxonConcept{
+			Verbatim: "(s.str.)", Type: parsed.SensuStrictoConcept,
+		}},
+		{"p.p.", "Aus bus (L.) Mill. sensu Smith non Jones, p.p.", "",
+			&parsed.TaxonConcept{
+				Verbatim: "sensu Smith non Jones, p.p.",
+				Type:     parsed.SensuConcept, According: "Smith", Non: "Jones",
+				ProParte: true,
+			}},
+		{"page", "Aus bus L. sec. Smith 2001: 23", "", &parsed.TaxonConcept{
+			Verbatim: "sec. Smith 2001: 23", Type: parsed.SensuConcept,
+			According: "Smith 2001: 23",
+		}},
+		{"page no space", "Aus bus L. sec. Smith 2001:23, s.l.", "",
+			&parsed.TaxonConcept{
+				Verbatim: "sec. Smith 2001:23, s.l.", Type: parsed.SensuConcept,
+				According: "Smith 2001:23",
+			}},
+		{"tail", "Aus bus s.l. (Smith) Jones", " (Smith) Jones",
+			&parsed.TaxonConcept{
+				Verbatim: "s.l.", Type: parsed.SensuLatoConcept,
+			}},
+	}
+	for _, v := range testData {
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, nomcode.Unknown,
+		)
+		out := sn.ToOutput(false)
+		assert.Equal(t, out.TaxonConcept, v.concept, v.msg)
+		assert.Equal(t, out.Tail, v.tail, v.msg)
+	}
+
+	sn := p.PreprocessAndParse(
+		"Aus bus auct. non L.", "test_version", true, false, false, false,
+		nomcode.Unknown,
+	)
+	out := sn.ToOutput(true)
+	assert.Equal(t, out.ParseQuality, 1)
+	assert.Equal(t, len(out.Words), 5)
+	w := out.Words[4]
+	assert.Equal(t, w.Type, parsed.ConceptAuthorType)
+	assert.Equal(t, w.Verbatim, "L.")
+	assert.Equal(t, w.Start, 18)
+	assert.Equal(t, w.End, 20)
+}
+
+func TestNomStatus(t *testing.T) {
+	p := &parser.Engine{Buffer: ""}
+	p.Init()
+	testData := []struct {
+		msg, name, tail string
+		status          []parsed.NomenclaturalStatus
+	}{
+		{"no status", "Aus bus L.", "", nil},
+		{"nom. nud.", "Aus bus L. nom. nud.", "", []parsed.NomenclaturalStatus{
+			{Verbatim: "nom. nud.", Status: parsed.NomenNudumStatus},
+		}},
+		{"nomen nudum", "Aus bus Smith, nomen nudum", "",
+			[]parsed.NomenclaturalStatus{
+				{Verbatim: "nomen nudum", Status: parsed.NomenNudumStatus},
+			}},
+		{"parens", "Aus bus L. (nom. inval.)", "", []parsed.NomenclaturalStatus{
+			{Verbatim: "nom. inval.", Status: parsed.NomenInvalidumStatus},
+		}},
+		{"comb. nov.", "Aus bus (L.) Mill. comb. nov.", "",
+			[]parsed.NomenclaturalStatus{
+				{Verbatim: "comb. nov.", Status: parsed.CombinatioNovaStatus},
+			}},
+		{"sp. nov.", "Aus bus Smith sp. nov.", "", []parsed.NomenclaturalStatus{
+			{Verbatim: "sp. nov.", Status: parsed.SpeciesNovaStatus},
+		}},
+		{"ined.", "Aus bus ined.", "", []parsed.NomenclaturalStatus{
+			{Verbatim: "ined.", Status: parsed.IneditusStatus},
+		}},
+		{"several", "Aus bus Mill. nom. illeg., nom. rej.", "",
+			[]parsed.NomenclaturalStatus{
+				{Verbatim: "nom. illeg.", Status: parsed.NomenIllegitimumStatus},
+				{Verbatim: "nom. rej.", Status: parsed.NomenRejiciendumStatus},
+			}},
+		{"concept", "Aus bus sensu Smith nom. nud.", "",
+			[]parsed.NomenclaturalStatus{
+				{Verbatim: "nom. nud.", Status: parsed.NomenNudumStatus},
+			}},
+		{"before concept", "Aus bus Mill. nom. illeg. sensu Smith", "",
+			[]parsed.NomenclaturalStatus{
+				{Verbatim: "nom. illeg.", Status: parsed.NomenIllegitimumStatus},
+			}},
+		{"around concept", "Aus bus nom. illeg. sensu Smith, nom. rej.", "",
+			[]parsed.NomenclaturalStatus{
+				{Verbatim: "nom. illeg.", Status: parsed.NomenIllegitimumStatus},
+				{Verbatim: "nom. rej.", Status: parsed.NomenRejiciendumStatus},
+			}},
+		{"tail", "Aus bus Smith, nom. illeg., non L.", " non L.",
+			[]parsed.NomenclaturalStatus{
+				{Verbatim: "nom. illeg.", Status: parsed.NomenIllegitimumStatus},
+			}},
+	}
+	for _, v := range testData {
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, nomcode.Unknown,
+		)
+		out := sn.ToOutput(false)
+		assert.Equal(t, out.NomenclaturalStatus, v.status, v.msg)
+		assert.Equal(t, out.Tail, v.tail, v.msg)
+	}
+
+	sn := p.PreprocessAndParse(
+		"Aus bus L. nom. nud.", "test_version", true, false, false, false,
+		nomcode.Unknown,
+	)
+	out := sn.ToOutput(true)
+	assert.Equal(t, out.ParseQuality, 1)
+	assert.Equal(t, len(out.Words), 5)
+	w := out.Words[3]
+	assert.Equal(t, w.Type, parsed.NomStatusType)
+	assert.Equal(t, w.Verbatim, "nom.")
+	assert.Equal(t, w.Start, 11)
+	assert.Equal(t, w.End, 15)
+}
+
+func TestAuthorDetails(t *testing.T) {
+	p := &parser.Engine{Buffer: ""}
+	p.Init()
+	testData := []struct {
+		msg, name string
+		authors   []parsed.Author
+	}{
+		{"no authors", "Aus bus", nil},
+		{"standard", "Aus bus L.", []parsed.Author{
+			{Value: "L.", Surname: "L.", Standard: "L.", Key: "l"},
+		}},
+		{"variants", "Aus bus (Linnaeus) Linn.", []parsed.Author{
+			{Value: "Linnaeus", Surname: "Linnaeus", Standard: "L.", Key: "l"},
+			{Value: "Linn.", Surname: "Linn.", Standard: "L.", Key: "l"},
+		}},
+		{"filius", "Aus bus Hook. f. & R. Br.", []parsed.Author{
+			{Value: "Hook. fil.", Surname: "Hook.", Filius: true,
+				Standard: "Hook.f.", Key: "hookf"},
+			{Value: "R. Br.", Initials: "R.", Surname: "Br.",
+				Standard: "R.Br.", Key: "rbr"},
+		}},
+		{"filius with initials", "Aus bus J. D. Hook. f.", []parsed.Author{
+			{Value: "J. D. Hook. fil.", Initials: "J. D.", Surname: "Hook.",
+				Filius: true, Standard: "Hook.f.", Key: "hookf"},
+		}},
+		{"filius added", "Aus bus Lam. fil.", []parsed.Author{
+			{Value: "Lam. fil.", Surname: "Lam.", Filius: true,
+				Standard: "Lam.f.", Key: "lamf"},
+		}},
+		{"filius no period", "Aus bus Britton f.", []parsed.Author{
+			{Value: "Britton fil.", Surname: "Britton", Filius: true,
+				Standard: "Britton f.", Key: "brittonf"},
+		}},
+		{"filius unknown", "Aus bus Smith f.", []parsed.Author{
+			{Value: "Smith fil.", Surname: "Smith", Filius: true,
+				Standard: "Smith fil.", Key: "smithf"},
+		}},
+		{"unknown", "Aus bus (Müller) Smith ex DC.", []parsed.Author{
+			{Value: "Müller", Surname: "Müller", Standard: "Müller",
+				Key: "mueller"},
+			{Value: "Smith", Surname: "Smith", Standard: "Smith", Key: "smith"},
+			{Value: "DC.", Surname: "DC.", Standard: "DC.", Key: "dc"},
+		}},
+		{"particles", "Aus bus van der Wijk & J. M. d'Urv. 1900", []parsed.Author{
+			{Value: "van der Wijk", Particles: "van der", Surname: "Wijk",
+				Standard: "van der Wijk", Key: "vanderwijk"},
+			{Value: "J. M. d'Urv.", Initials: "J. M.", Particles: "d'",
+				Surname: "Urv.", Standard: "J. M. d'Urv.", Key: "jmdurv"},
+		}},
+		{"suffix", "Aus bus M.-A. Smith Jr. & Airy Shaw", []parsed.Author{
+			{Value: "M.-A. Smith Jr.", Initials: "M.-A.", Surname: "Smith",
+				Suffix: "Jr.", Standard: "M.-A. Smith Jr.", Key: "masmithjr"},
+			{Value: "Airy Shaw", Surname: "Airy Shaw", Standard: "Airy Shaw",
+				Key: "airyshaw"},
+		}},
+		{"unknown author", "Aus bus anon.", []parsed.Author{
+			{Value: "anon.", Standard: "anon.", Key: "anon"},
+		}},
+	}
+	for _, v := range testData {
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, nomcode.Unknown,
+		)
+		out := sn.ToOutput(true)
+		var res []parsed.Author
+		if out.Authorship != nil {
+			res = out.Authorship.AuthorDetails
+		}
+		assert.Equal(t, res, v.authors, v.msg)
+
+		out = sn.ToOutput(false)
+		if out.Authorship != nil {
+			assert.Nil(t, out.Authorship.AuthorDetails, v.msg)
+		}
+	}
+}
+
+func TestYear(t *testing.T) {
+	p := &parser.Engine{Buffer: ""}
+	p.Init()
+	testData := []struct {
+		msg, name string
+		code      nomcode.Code
+		year      *parsed.Year
+		warns     []parsed.Warning
+	}{
+		{"year", "Aus bus Smith, 1900", nomcode.Unknown,
+			&parsed.Year{Value: "1900", Verbatim: "1900", Start: 1900, End: 1900},
+			nil},
+		{"range", "Aus bus Smith, 1880-85", nomcode.Unknown,
+			&parsed.Year{Value: "1880", IsApproximate: true, Verbatim: "1880-85",
+				Start: 1880, End: 1885},
+			[]parsed.Warning{parsed.YearRangeWarn}},
+		{"range century", "Aus bus Smith 1899/01", nomcode.Unknown,
+			&parsed.Year{Value: "1899", IsApproximate: true, Verbatim: "1899/01",
+				Start: 1899, End: 1901},
+			[]parsed.Warning{parsed.YearRangeWarn}},
+		{"question", "Aus bus Smith 188?", nomcode.Unknown,
+			&parsed.Year{Value: "188?", IsApproximate: true, Verbatim: "188?",
+				Start: 1880, End: 1889},
+			[]parsed.Warning{parsed.YearQuestionWarn}},
+		{"parens", "Aus bus Smith (1900)", nomcode.Unknown,
+			&parsed.Year{Value: "1900", IsApproximate: true, Verbatim: "(1900)",
+				Start: 1900, End: 1900},
+			[]parsed.Warning{parsed.YearParensWarn}},
+		{"too early", "Aus bus Smith 1700", nomcode.Unknown,
+			&parsed.Year{Value: "1700", Verbatim: "1700", Start: 1700, End: 1700},
+			[]parsed.Warning{parsed.YearTooEarlyWarn}},
+		{"botany", "Aus bus L. 1753", nomcode.Botanical,
+			&parsed.Year{Value: "1753", Verbatim: "1753", Start: 1753, End: 1753},
+			nil},
+		{"too early zoo", "Aus bus L. 1753", nomcode.Zoological,
+			&parsed.Year{Value: "1753", Verbatim: "1753", Start: 1753, End: 1753},
+			[]parsed.Warning{parsed.YearTooEarlyWarn}},
+		{"too early inferred zoo", "Aus bus Smith, 1755", nomcode.Unknown,
+			&parsed.Year{Value: "1755", Verbatim: "1755", Start: 1755, End: 1755},
+			[]parsed.Warning{parsed.YearTooEarlyWarn}},
+		{"ambiguous code", "Aus bus (Smith 1755) Mill.", nomcode.Unknown,
+			&parsed.Year{Value: "1755", Verbatim: "1755", Start: 1755, End: 1755},
+			nil},
+		{"future", "Aus bus Smith 2990", nomcode.Unknown,
+			&parsed.Year{Value: "2990", Verbatim: "2990", Start: 2990, End: 2990},
+			[]parsed.Warning{parsed.YearFutureWarn}},
+	}
+	for _, v := range testData {
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, v.code,
+		)
+		out := sn.ToOutput(true)
+		assert.Equal(t, out.Authorship.Original.Year, v.year, v.msg)
+		var warns []parsed.Warning
+		for _, w := range out.QualityWarnings {
+			warns = append(warns, w.Warning)
+		}
+		assert.Equal(t, warns, v.warns, v.msg)
+	}
+}
+
+func TestYearFuture(t *testing.T) {
+	next := time.Now().Year() + 1
+	testData := []struct {
+		msg  string
+		p    parser.Parser
+		year int
+		warn bool
+	}{
+		{"next year", parser.New(), next, false},
+		{"after next year", parser.New(), next + 1, true},
+		{"test next year", parser.NewTest(), 2027, false},
+		{"test after next year", parser.NewTest(), 2028, true},
+	}
+	for _, v := range testData {
+		name := fmt.Sprintf("Aus bus Smith %d", v.year)
+		sn := v.p.PreprocessAndParse(
+			name, "test_version", true, false, false, false, nomcode.Unknown,
+		)
+		out := sn.ToOutput(false)
+		var warn bool
+		for _, w := range out.QualityWarnings {
+			if w.Warning == parsed.YearFutureWarn {
+				warn = true
+			}
+		}
+		assert.Equal(t, warn, v.warn, v.msg)
+	}
+}
+
+func TestYearCombination(t *testing.T) {
+	p := &parser.Engine{Buffer: ""}
+	p.Init()
+	testData := []struct {
+		msg, name string
+		warn      bool
+	}{
+		{"later", "Aus bus (Smith 1900) Jones 1910", false},
+		{"earlier", "Aus bus (Smith 1900) Jones 1890", true},
+		{"approximate", "Aus bus (Smith 1900) Jones 190?", false},
+		{"no year", "Aus bus (Smith 1900) Jones", false},
+	}
+	for _, v := range testData {
+		sn := p.PreprocessAndParse(
+			v.name, "test_version", true, false, false, false, nomcode.Unknown,
+		)
+		out := sn.ToOutput(false)
+		var warns []parsed.Warning
+		for _, w := range out.QualityWarnings {
+			warns = append(warns, w.Warning)
+		}
+		if v.warn {
+			assert.Contains(t, warns, parsed.YearCombBeforeOrigWarn, v.msg)
+		} else {
+			assert.NotContains(t, warns, parsed.YearCombBeforeOrigWarn, v.msg)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package parser
+
+import (
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// rank returns the normalized rank of the most fine-grained element of
+// a name. Hybrid formulas, graft-chimera formulas and viruses do not
+// have a rank. If the suffix of a uninomial means different ranks in
+// different codes, a warning is added.
+func (sn *scientificNameNode) rank(code nomcode.Code) parsed.Rank {
+	r, ambiguous := nameRank(sn.nameData, code)
+	if ambiguous {
+		if sn.warnings == nil {
+			sn.warnings = make(map[parsed.Warning]struct{})
+		}
+		sn.warnings[parsed.RankSuffixAmbiguousWarn] = struct{}{}
+	}
+	return r
+}
+
+func nameRank(nd nameData, code nomcode.Code) (parsed.Rank, bool) {
+	switch n := nd.(type) {
+	case *uninomialNode:
+		sr := newSuffixRank(n.Word.Normalized, code)
+		return sr.rank, sr.ambiguous
+	case *uninomialComboNode:
+		return parsed.NewRank(n.Rank.Word.Normalized), false
+	case *speciesNode:
+		return infraspRank(n.Infraspecies, code), false
+	case *namedSpeciesHybridNode:
+		return infraspRank(n.Infraspecies, code), false
+	case *namedGenusHybridNode:
+		return nameRank(n.nameData, code)
+	case *namedGenusGraftChimeraNode:
+		return nameRank(n.nameData, code)
+	case *candidatusNameNode:
+		return nameRank(n.SingleName, code)
+	case *comparisonNode:
+		if n.SpEpithet != nil {
+			return parsed.SpeciesRank, false
+		}
+	case *approxNode:
+		if n.SpEpithet != nil {
+			return parsed.SpeciesRank, false
+		}
+	}
+	return parsed.NoRank, false
+}
+
+// infraspRank returns the rank of the last infraspecific epithet, or
+// SpeciesRank if there are no infraspecific epithets.
+func infraspRank(infs []*infraspEpithetNode, code nomcode.Code) parsed.Rank {
+	if len(infs) == 0 {
+		return parsed.SpeciesRank
+	}
+	inf := infs[len(infs)-1]
+	if inf == nil {
+		return parsed.NoRank
+	}
+	if inf.Rank != nil && inf.Rank.Word != nil {
+		return parsed.NewRank(inf.Rank.Word.Normalized)
+	}
+	return unrankedRank(len(infs), code)
+}
+
+// unrankedRank infers the rank of an infraspecific epithet without a rank
+// marker. Zoological trinomials do not use rank markers, so such epithets
+// are subspecies, if the code is given as zoological, or is inferred as
+// zoological.
+func unrankedRank(infsNum int, code nomcode.Code) parsed.Rank {
+	if infsNum == 1 && code == nomcode.Zoological {
+		return parsed.SubspeciesRank
+	}
+	return parsed.InfraspecificRank
+}
+
+// inferDetailsRanks sets ranks of infraspecific epithets that do not have
+// rank markers, and ranks of single uninomials that have standardized
+// suffixes.
+func inferDetailsRanks(d parsed.Details, code nomcode.Code) parsed.Details {
+	switch dt := d.(type) {
+	case parsed.DetailsUninomial:
+		u := &dt.Uninomial
+		if u.Parent == "" && u.RankNormalized == parsed.NoRank {
+			sr := newSuffixRank(u.Value, code)
+			u.RankNormalized, u.RankCode = sr.rank, sr.code
+		}
+		return dt
+	case parsed.DetailsInfraspecies:
+		isp := dt.Infraspecies.Infraspecies
+		for i := range isp {
+			if isp[i].RankNormalized == parsed.NoRank {
+				isp[i].RankNormalized = unrankedRank(len(isp), code)
+			}
+		}
+	case parsed.DetailsHybridFormula:
+		for i, v := range dt.HybridFormula {
+			dt.HybridFormula[i] = inferDetailsRanks(v, code)
+		}
+	case parsed.DetailsGraftChimeraFormula:
+		for i, v := range dt.GraftChimeraFormula {
+			dt.GraftChimeraFormula[i] = inferDetailsRanks(v, code)
+		}
+	}
+	return d
+}
+
+// codeRank is a rank that is mandated for a suffix by a nomenclatural code.
+type codeRank struct {
+	code nomcode.Code
+	rank parsed.Rank
+}
+
+// rankSuffixes are endings of names above genus that are mandated by
+// nomenclatural codes (ICN Art. 16-19, ICZN Art. 29, ICNP Rule 8). Botany
+// goes before bacteriology, so the botanical code is chosen if both codes
+// mandate the same rank.
+var rankSuffixes = map[string][]codeRank{
+	"phyta":     {{nomcode.Botanical, parsed.DivisionRank}},
+	"mycota":    {{nomcode.Botanical, parsed.DivisionRank}},
+	"phytina":   {{nomcode.Botanical, parsed.SubdivisionRank}},
+	"mycotina":  {{nomcode.Botanical, parsed.SubdivisionRank}},
+	"opsida":    {{nomcode.Botanical, parsed.ClassRank}},
+	"phyceae":   {{nomcode.Botanical, parsed.ClassRank}},
+	"mycetes":   {{nomcode.Botanical, parsed.ClassRank}},
+	"phycidae":  {{nomcode.Botanical, parsed.SubclassRank}},
+	"mycetidae": {{nomcode.Botanical, parsed.SubclassRank}},
+	"anae":      {{nomcode.Botanical, parsed.SuperorderRank}},
+	"ota":       {{nomcode.Bacterial, parsed.PhylumRank}},
+	"oidea":     {{nomcode.Zoological, parsed.SuperfamilyRank}},
+	"ini":       {{nomcode.Zoological, parsed.TribeRank}},
+	"ales": {
+		{nomcode.Botanical, parsed.OrderRank},
+		{nomcode.Bacterial, parsed.OrderRank},
+	},
+	"ineae": {
+		{nomcode.Botanical, parsed.SuborderRank},
+		{nomcode.Bacterial, parsed.SuborderRank},
+	},
+	"aceae": {
+		{nomcode.Botanical, parsed.FamilyRank},
+		{nomcode.Bacterial, parsed.FamilyRank},
+	},
+	"oideae": {
+		{nomcode.Botanical, parsed.SubfamilyRank},
+		{nomcode.Bacterial, parsed.SubfamilyRank},
+	},
+	"eae": {
+		{nomcode.Botanical, parsed.TribeRank},
+		{nomcode.Bacterial, parsed.TribeRank},
+	},
+	"idae": {
+		{nomcode.Botanical, parsed.SubclassRank},
+		{nomcode.Zoological, parsed.FamilyRank},
+	},
+	"inae": {
+		{nomcode.Botanical, parsed.SubtribeRank},
+		{nomcode.Bacterial, parsed.SubtribeRank},
+		{nomcode.Zoological, parsed.SubfamilyRank},
+	},
+}
+
+// minSuffixStem is the minimal length of a uninomial before a suffix.
+// It prevents inference for short generic names like "Lota".
+const minSuffixStem = 2
+
+// suffixStems are minimal stems of suffixes that are common endings of
+// generic names, for example "Biota" or "Ascoidea".
+var suffixStems = map[string]int{
+	"ota":   5,
+	"oidea": 4,
+}
+
+// suffixRank is a rank of a uninomial inferred from its suffix.
+type suffixRank struct {
+	rank parsed.Rank
+	code nomcode.Code
+	// ambiguous is true if the suffix means different ranks in
+	// different codes, and the code of the name is not known.
+	ambiguous bool
+}
+
+// newSuffixRank infers the rank of a uninomial from the longest of its
+// standardized suffixes. If the code of the name is given or inferred,
+// the rank of that code is used, otherwise the rank is inferred only if
+// all codes agree on it.
+func newSuffixRank(uninomial string, code nomcode.Code) suffixRank {
+	var res suffixRank
+	if code == nomcode.Cultivars {
+		code = nomcode.Botanical
+	}
+
+	var crs []codeRank
+	for i := minSuffixStem; i < len(uninomial); i++ {
+		var ok bool
+		suffix := uninomial[i:]
+		if stem, ok := suffixStems[suffix]; ok && i < stem {
+			continue
+		}
+		if crs, ok = rankSuffixes[suffix]; ok {
+			break
+		}
+	}
+	if len(crs) == 0 {
+		return res
+	}
+
+	for _, v := range crs {
+		if v.code == code {
+			res.rank, res.code = v.rank, v.code
+			return res
+		}
+	}
+
+	for _, v := range crs[1:] {
+		if v.rank != crs[0].rank {
+			res.ambiguous = true
+			return res
+		}
+	}
+	res.rank, res.code = crs[0].rank, crs[0].code
+	return res
+}
+
+// rankCode returns the nomenclatural code used to infer ranks. The code
+// given to the parser has priority over the inferred one.
+func rankCode(code nomcode.Code, ci *parsed.CodeInference) nomcode.Code {
+	if code == nomcode.Unknown && ci != nil {
+		return ci.Code
+	}
+	return code
+}
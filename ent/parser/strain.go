@@ -0,0 +1,166 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// strainField is a field of parsed.Strain a value belongs to.
+type strainField int
+
+const (
+	designationField strainField = iota
+	serovarField
+	pathovarField
+	biovarField
+)
+
+// strainMarkers are words that introduce values of strain fields.
+var strainMarkers = map[string]strainField{
+	"str.":     designationField,
+	"strain":   designationField,
+	"serovar":  serovarField,
+	"serotype": serovarField,
+	"ser.":     serovarField,
+	"sv.":      serovarField,
+	"pv.":      pathovarField,
+	"pathovar": pathovarField,
+	"bv.":      biovarField,
+	"biovar":   biovarField,
+}
+
+var (
+	strainCollectionRe = regexp.MustCompile(
+		`^(ATCC|DSMZ?|NCTC|NCIMB|JCM|LMG|CIP|NRRL|CCUG|NBRC|KCTC|CECT|ICMP|NCPPB)` +
+			`[:-]?(\d[\p{L}\d.-]*)?$`,
+	)
+	strainNumberRe  = regexp.MustCompile(`^\d[\p{L}\d.-]*$`)
+	strainAntigenRe = regexp.MustCompile(`^O\d+(:[HK]\d+)*$`)
+	strainDesignRe  = regexp.MustCompile(`^[\p{L}\d][\p{L}\d:._/-]*$`)
+)
+
+// addStrain finds strain information of a bacterial name. The pathovar
+// comes from the name itself, other fields are taken from the tail.
+// Recognized part of the tail is removed from it.
+func (sn *scientificNameNode) addStrain(code nomcode.Code) {
+	if sn.nameData == nil || !sn.isBacterial(code) {
+		return
+	}
+
+	var st parsed.Strain
+	ws := sn.words()
+	for i := range ws {
+		if ws[i].Type != parsed.RankType || i+1 == len(ws) {
+			continue
+		}
+		if f, ok := strainMarkers[ws[i].Normalized]; ok && f == pathovarField {
+			st.Pathovar = ws[i+1].Normalized
+		}
+	}
+
+	tail := parseStrain(sn.tail, &st)
+	if st.Pathovar == "" && st.Verbatim == "" {
+		return
+	}
+	sn.strain = &st
+	sn.tail = tail
+}
+
+func (sn *scientificNameNode) isBacterial(code nomcode.Code) bool {
+	if code == nomcode.Bacterial {
+		return true
+	}
+	return sn.bacteria != nil && sn.bacteria.Valid && sn.bacteria.Value >= 0
+}
+
+// parseStrain collects strain information from the start of a tail. It
+// returns the part of the tail that was not recognized.
+func parseStrain(tail string, st *parsed.Strain) string {
+	toks := spaceTokens(tail)
+	var i, end int
+	for i < len(toks) {
+		val := strings.TrimRight(toks[i].val, ",;")
+		next := ""
+		if i+1 < len(toks) {
+			next = strings.TrimRight(toks[i+1].val, ",;")
+		}
+
+		if f, ok := strainMarkers[strings.ToLower(val)]; ok {
+			if next == "" || !strainDesignRe.MatchString(next) ||
+				!setStrainField(st, f, next) {
+				break
+			}
+			i += 2
+		} else if m := strainCollectionRe.FindStringSubmatch(val); m != nil {
+			num := m[2]
+			if num == "" {
+				if !strainNumberRe.MatchString(next) {
+					break
+				}
+				num = next
+				i++
+			}
+			st.Accessions = append(st.Accessions, m[1]+" "+num)
+			i++
+		} else if val == "=" && i > 0 {
+			i++
+			continue
+		} else if strainAntigenRe.MatchString(val) && st.Serovar == "" {
+			st.Serovar = val
+			i++
+		} else if isStrainDesignation(val) && st.Designation == "" {
+			st.Designation = val
+			i++
+		} else {
+			break
+		}
+		end = toks[i-1].bytes
+	}
+
+	if end == 0 {
+		return tail
+	}
+	st.Verbatim = strings.TrimSpace(strings.TrimRight(tail[:end], ",;"))
+	return strings.TrimRight(tail[end:], " ")
+}
+
+// setStrainField sets a value of a field, if the field is still empty.
+func setStrainField(st *parsed.Strain, f strainField, val string) bool {
+	var fld *string
+	switch f {
+	case designationField:
+		fld = &st.Designation
+	case serovarField:
+		fld = &st.Serovar
+	case pathovarField:
+		fld = &st.Pathovar
+	case biovarField:
+		fld = &st.Biovar
+	}
+	if *fld != "" {
+		return false
+	}
+	*fld = val
+	return true
+}
+
+// isStrainDesignation checks if a word looks like a strain designation,
+// for example "K-12" or "LT2".
+func isStrainDesignation(s string) bool {
+	if !strainDesignRe.MatchString(s) {
+		return false
+	}
+	var upper int
+	for _, v := range s {
+		switch {
+		case v >= '0' && v <= '9':
+			return true
+		case v >= 'A' && v <= 'Z':
+			upper++
+		}
+	}
+	return upper > 1
+}
@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// spaceToken is a space-delimited token of a name-string.
+type spaceToken struct {
+	val string
+	// start and end are positions of the token in runes.
+	start, end int
+	// bytes are the position of the end of the token in bytes.
+	bytes int
+}
+
+// spaceTokens splits a string by spaces and remembers positions of the
+// tokens.
+func spaceTokens(s string) []spaceToken {
+	var res []spaceToken
+	var tok []rune
+	var start, pos int
+	for i, v := range s {
+		if v == ' ' {
+			if len(tok) > 0 {
+				res = append(res, spaceToken{
+					val: string(tok), start: start, end: pos, bytes: i,
+				})
+				tok = tok[:0]
+			}
+			pos++
+			continue
+		}
+		if len(tok) == 0 {
+			start = pos
+		}
+		tok = append(tok, v)
+		pos++
+	}
+	if len(tok) > 0 {
+		res = append(res, spaceToken{
+			val: string(tok), start: start, end: pos, bytes: len(s),
+		})
+	}
+	return res
+}
+
+func (t spaceToken) word(wt parsed.WordType) parsed.Word {
+	return parsed.Word{
+		Verbatim:   t.val,
+		Normalized: t.val,
+		Type:       wt,
+		Start:      t.start,
+		End:        t.end,
+	}
+}
+
+func (t spaceToken) trimLeft(cut string) spaceToken {
+	if strings.HasPrefix(t.val, cut) {
+		t.val = t.val[len(cut):]
+		t.start++
+	}
+	return t
+}
+
+func (t spaceToken) trimRight(cut string) spaceToken {
+	if strings.HasSuffix(t.val, cut) {
+		t.val = t.val[:len(t.val)-len(cut)]
+		t.end--
+	}
+	return t
+}
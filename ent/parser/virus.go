@@ -0,0 +1,317 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// virusNode keeps elements of a virus name. Virus names are not parsed by
+// PEG grammar, they go through a simpler tokenizer-based parser instead.
+type virusNode struct {
+	// Genus is a genus of an ICTV binomial (or a standalone ICTV genus).
+	Genus *parsed.Word
+	// Species is a specific epithet of an ICTV binomial.
+	Species *parsed.Word
+	// Name keeps words of a legacy (non-binomial) virus name, for example
+	// "Tobacco mosaic virus".
+	Name []parsed.Word
+	// Strain keeps words of a strain or isolate designation.
+	Strain []parsed.Word
+	// StrainMarker is a verbatim word that introduces the strain, for
+	// example "strain" or "isolate".
+	StrainMarker string
+	// Acronym is an acronym given in parentheses, for example "(TMV)".
+	Acronym *parsed.Word
+}
+
+var (
+	virusWordRe  = regexp.MustCompile(`(?i)(virus|viruses|phage|phages|virophage)$`)
+	virusGenusRe = regexp.MustCompile(`^\p{Lu}[\p{Ll}\d]*virus$`)
+	virusEpithRe = regexp.MustCompile(`^\p{Ll}[\p{Ll}-]*\p{Ll}$`)
+	virusNameRe  = regexp.MustCompile(`^\p{L}[\p{L}\d'.-]*$`)
+	virusStrRe   = regexp.MustCompile(`^[\p{L}\d][\p{L}\d/._:-]*$`)
+	virusNumRe   = regexp.MustCompile(`^(\d+|[IVX]+)$`)
+)
+
+// virusMarkers are words that introduce strain or isolate designations.
+var virusMarkers = map[string]struct{}{
+	"strain":   {},
+	"str.":     {},
+	"isolate":  {},
+	"isol.":    {},
+	"clone":    {},
+	"variant":  {},
+	"genotype": {},
+	"serotype": {},
+}
+
+// parseVirus tries to parse a name-string as a name of a virus. It
+// recognizes ICTV binomials ("Betacoronavirus pandemicum"), ICTV genera,
+// and legacy names ("Tobacco mosaic virus"), followed by optional strain
+// designation and an acronym in parentheses. A bare number or a Roman
+// numeral right after "virus" is a part of the name, as in
+// "Human immunodeficiency virus 1". Everything that follows is
+// treated as an unparsed tail. If the string does not look like a virus
+// name, it returns false.
+func (p *Engine) parseVirus(s string) bool {
+	toks := spaceTokens(s)
+	if len(toks) == 0 {
+		return false
+	}
+
+	idx := -1
+	for i := range toks {
+		if !virusNameRe.MatchString(toks[i].val) {
+			return false
+		}
+		if virusWordRe.MatchString(toks[i].val) {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return false
+	}
+
+	last := idx
+	if idx+1 < len(toks) && virusNumRe.MatchString(toks[idx+1].val) &&
+		strings.HasSuffix(strings.ToLower(toks[idx].val), "virus") {
+		last = idx + 1
+	}
+
+	p.fullReset()
+	vn := &virusNode{}
+	var cardinality, rest int
+	if last == 0 && virusGenusRe.MatchString(toks[0].val) {
+		gen := toks[0].word(parsed.GenusType)
+		vn.Genus = &gen
+		cardinality, rest = 1, 1
+		if isVirusEpithet(toks, 1) {
+			sp := toks[1].word(parsed.SpEpithetType)
+			vn.Species = &sp
+			cardinality, rest = 2, 2
+		}
+	} else {
+		for _, v := range toks[0 : last+1] {
+			vn.Name = append(vn.Name, v.word(parsed.VirusNameType))
+		}
+		rest = last + 1
+	}
+
+	rest = vn.parseRest(toks, rest)
+	var tail string
+	if rest < len(toks) {
+		tail = strings.TrimRight(s[toks[rest-1].bytes:], " ")
+	}
+
+	p.addEvidence(parsed.VirusEvidence)
+	p.sn = &scientificNameNode{
+		nameData:    vn,
+		cardinality: cardinality,
+		virus:       true,
+		tail:        tail,
+		evidence:    p.evidence,
+	}
+	return true
+}
+
+// isVirusEpithet checks if a token at i is a specific epithet of an ICTV
+// binomial. It is true if the token is a lowercase word followed by
+// the end of the string, a strain marker, an acronym, or a strain
+// designation.
+func isVirusEpithet(toks []spaceToken, i int) bool {
+	if i >= len(toks) || !virusEpithRe.MatchString(toks[i].val) {
+		return false
+	}
+	if _, ok := virusMarkers[toks[i].val]; ok {
+		return false
+	}
+	if i+1 == len(toks) {
+		return true
+	}
+	next := toks[i+1].val
+	if _, ok := virusMarkers[strings.ToLower(next)]; ok {
+		return true
+	}
+	r := []rune(next)[0]
+	return r == '(' || unicode.IsUpper(r) || unicode.IsDigit(r)
+}
+
+// parseRest collects strain designation and an acronym that follow
+// the name of a virus. It returns the index of the first token that
+// did not fit.
+func (vn *virusNode) parseRest(toks []spaceToken, i int) int {
+	for i < len(toks) {
+		t := toks[i]
+		_, isMarker := virusMarkers[strings.ToLower(t.val)]
+		switch {
+		case t.val == "-" && len(vn.Strain) == 0 && i+1 < len(toks) &&
+			isVirusStrain(toks[i+1].val):
+			i++
+		case strings.HasPrefix(t.val, "("):
+			j := i
+			for j < len(toks) && !strings.HasSuffix(toks[j].val, ")") {
+				j++
+			}
+			if j == len(toks) || !vn.parseParens(toks[i:j+1]) {
+				return i
+			}
+			i = j + 1
+		case isMarker && len(vn.Strain) == 0 && i+1 < len(toks) &&
+			virusStrRe.MatchString(toks[i+1].val):
+			vn.StrainMarker = t.val
+			vn.Strain = append(vn.Strain, toks[i+1].word(parsed.VirusStrainType))
+			i += 2
+		case len(vn.Strain) == 0 && isVirusStrain(t.val):
+			vn.Strain = append(vn.Strain, t.word(parsed.VirusStrainType))
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// parseParens parses content of parentheses, which might be either an
+// acronym "(TMV)", or a strain "(strain R1)".
+func (vn *virusNode) parseParens(toks []spaceToken) bool {
+	ts := make([]spaceToken, len(toks))
+	copy(ts, toks)
+	ts[0] = ts[0].trimLeft("(")
+	ts[len(ts)-1] = ts[len(ts)-1].trimRight(")")
+
+	if len(ts) == 2 && len(vn.Strain) == 0 {
+		if _, ok := virusMarkers[strings.ToLower(ts[0].val)]; ok &&
+			virusStrRe.MatchString(ts[1].val) {
+			vn.StrainMarker = ts[0].val
+			vn.Strain = append(vn.Strain, ts[1].word(parsed.VirusStrainType))
+			return true
+		}
+	}
+
+	if len(ts) == 1 && vn.Acronym == nil && isVirusAcronym(ts[0].val) {
+		w := ts[0].word(parsed.VirusAcronymType)
+		vn.Acronym = &w
+		return true
+	}
+	return false
+}
+
+func isVirusStrain(s string) bool {
+	if !virusStrRe.MatchString(s) {
+		return false
+	}
+	for _, v := range s {
+		if unicode.IsUpper(v) || unicode.IsDigit(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func isVirusAcronym(s string) bool {
+	if !virusStrRe.MatchString(s) {
+		return false
+	}
+	for _, v := range s {
+		if unicode.IsUpper(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (vn *virusNode) value() string {
+	res := vn.name()
+	if len(vn.Strain) > 0 {
+		if vn.StrainMarker != "" {
+			res += " " + vn.StrainMarker
+		}
+		res += " " + vn.strain()
+	}
+	if vn.Acronym != nil {
+		res += " (" + vn.Acronym.Normalized + ")"
+	}
+	return res
+}
+
+// canonical of a legacy virus name keeps its strain designation, so
+// different strains or phages of the same host do not share canonical
+// forms. ICTV binomials and genera do not include strains.
+func (vn *virusNode) canonical() *canonical {
+	res := vn.name()
+	if vn.isLegacy() && len(vn.Strain) > 0 {
+		res += " " + vn.strain()
+	}
+	return &canonical{Value: res, ValueRanked: res}
+}
+
+// name returns the name of a virus without strain and acronym.
+func (vn *virusNode) name() string {
+	if vn.Genus == nil {
+		return joinWords(vn.Name)
+	}
+	res := vn.Genus.Normalized
+	if vn.Species != nil {
+		res += " " + vn.Species.Normalized
+	}
+	return res
+}
+
+// isLegacy is true for virus names that are not ICTV binomials or genera.
+// Such names are not stemmed.
+func (vn *virusNode) isLegacy() bool {
+	return vn.Genus == nil
+}
+
+func (vn *virusNode) strain() string {
+	return joinWords(vn.Strain)
+}
+
+func (vn *virusNode) words() []parsed.Word {
+	var res []parsed.Word
+	if vn.Genus != nil {
+		res = append(res, *vn.Genus)
+	}
+	if vn.Species != nil {
+		res = append(res, *vn.Species)
+	}
+	res = append(res, vn.Name...)
+	res = append(res, vn.Strain...)
+	if vn.Acronym != nil {
+		res = append(res, *vn.Acronym)
+	}
+	return res
+}
+
+func (vn *virusNode) lastAuthorship() *authorshipNode {
+	return nil
+}
+
+func (vn *virusNode) details() parsed.Details {
+	res := parsed.Virus{
+		Name:   vn.name(),
+		Strain: vn.strain(),
+	}
+	if vn.Genus != nil {
+		res.Genus = vn.Genus.Normalized
+	}
+	if vn.Species != nil {
+		res.Species = vn.Species.Normalized
+	}
+	if vn.Acronym != nil {
+		res.Acronym = vn.Acronym.Normalized
+	}
+	return parsed.DetailsVirus{Virus: res}
+}
+
+func joinWords(ws []parsed.Word) string {
+	res := make([]string, len(ws))
+	for i := range ws {
+		res[i] = ws[i].Normalized
+	}
+	return strings.Join(res, " ")
+}
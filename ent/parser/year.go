@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+const (
+	// firstYearICN is the starting point of botanical nomenclature
+	// (Species Plantarum).
+	firstYearICN = 1753
+	// firstYearICZN is the starting point of zoological nomenclature
+	// (Systema Naturae, 10th edition).
+	firstYearICZN = 1758
+	// testLatestYear is the latest year used by parsers created for tests,
+	// so test results do not depend on the time of parsing.
+	testLatestYear = 2027
+)
+
+// yearBounds returns the earliest and the latest years for a normalized
+// year. Question marks in place of digits make a range, for example
+// "188?" gives 1880 and 1889. It returns zeros if the year is not a number.
+func yearBounds(s string) (int, int) {
+	if len(s) < 4 {
+		return 0, 0
+	}
+	s = s[:4]
+	start, err := strconv.Atoi(strings.ReplaceAll(s, "?", "0"))
+	if err != nil {
+		return 0, 0
+	}
+	end, _ := strconv.Atoi(strings.ReplaceAll(s, "?", "9"))
+	return start, end
+}
+
+// yearRangeEnd finds the last year of a range like "1880-1885", "1880-85"
+// or "1899/01".
+func yearRangeEnd(start int, s string) int {
+	idx := strings.IndexAny(s, "-/")
+	if idx == -1 || start == 0 {
+		return start
+	}
+	var digits string
+	for _, v := range s[idx+1:] {
+		if v < '0' || v > '9' {
+			break
+		}
+		digits += string(v)
+	}
+	if digits == "" || len(digits) > 4 {
+		return start
+	}
+	prefix := strconv.Itoa(start)[:4-len(digits)]
+	end, _ := strconv.Atoi(prefix + digits)
+	if end < start && len(digits) < 4 {
+		end += pow10(len(digits))
+	}
+	if end < start {
+		return start
+	}
+	return end
+}
+
+func pow10(n int) int {
+	res := 1
+	for i := 0; i < n; i++ {
+		res *= 10
+	}
+	return res
+}
+
+// checkYear adds a warning for a year in the future, and keeps the
+// earliest year of the name. The earliest year is checked when the
+// nomenclatural code of the name is known (see checkEarliestYear).
+func (p *Engine) checkYear(yr *yearNode) {
+	if yr.Start == 0 {
+		return
+	}
+	if p.earliestYear == 0 || yr.End < p.earliestYear {
+		p.earliestYear = yr.End
+	}
+	if yr.Start > p.lastYear() {
+		p.addWarn(parsed.YearFutureWarn)
+	}
+}
+
+// lastYear returns the last year that is not in the future. It allows
+// for names that are dated by the next year.
+func (p *Engine) lastYear() int {
+	if p.latestYear > 0 {
+		return p.latestYear
+	}
+	return time.Now().Year() + 1
+}
+
+// checkEarliestYear adds a warning if a year of the name is earlier than
+// the start of nomenclature. The code is either given to the parser,
+// or inferred from the name.
+func (sn *scientificNameNode) checkEarliestYear(code nomcode.Code) {
+	if sn.earliestYear == 0 {
+		return
+	}
+	first := firstYearICN
+	if code == nomcode.Zoological {
+		first = firstYearICZN
+	}
+	if sn.earliestYear >= first {
+		return
+	}
+	if sn.warnings == nil {
+		sn.warnings = make(map[parsed.Warning]struct{})
+	}
+	sn.warnings[parsed.YearTooEarlyWarn] = struct{}{}
+}
@@ -22,8 +22,14 @@ type gnparser struct {
 	// cfg keeps gnparser settings.
 	cfg Config
 
-	// parser keeps parsing engine
-	parser parser.Parser
+	// pool keeps parsing engines. An engine keeps mutable state during
+	// parsing, so every call takes its own engine from the pool, which
+	// makes GNparser safe for concurrent use.
+	pool *sync.Pool
+
+	// cache keeps recently parsed results, it is nil if CacheSize
+	// is 0.
+	cache *cache
 }
 
 // New constructor function takes options organized into a
@@ -31,42 +37,87 @@ type gnparser struct {
 // interface.
 func New(cfg Config) GNparser {
 	gnp := gnparser{cfg: cfg}
-	gnp.parser = parser.New()
+	gnp.pool = &sync.Pool{
+		New: func() interface{} {
+			if cfg.IsTest {
+				return parser.NewTest()
+			}
+			return parser.New()
+		},
+	}
+	gnp.cache = newCache(cfg.CacheSize)
 	return gnp
 }
 
 // Debug returns byte representation of complete and 'output' syntax trees.
 func (gnp gnparser) Debug(s string) []byte {
-	return gnp.parser.Debug(s)
+	p := gnp.pool.Get().(parser.Parser)
+	defer gnp.pool.Put(p)
+	return p.Debug(s)
 }
 
 // Parse function parses input string according to configurations.
 // It takes a string and returns an parsed.Parsed object.
+// It is safe to call ParseName from several goroutines.
 func (gnp gnparser) ParseName(s string) parsed.Parsed {
+	if gnp.cache == nil {
+		return gnp.parseName(s)
+	}
+
+	key := newCacheKey(s, gnp.cfg)
+	if res, ok := gnp.cache.get(key); ok {
+		return res
+	}
+	res := gnp.parseName(s)
+	gnp.cache.add(key, res)
+	return res
+}
+
+func (gnp gnparser) parseName(s string) parsed.Parsed {
 	ver := Version
 	if gnp.cfg.IsTest {
 		ver = "test_version"
 	}
-	sciNameNode := gnp.parser.PreprocessAndParse(
+	p := gnp.pool.Get().(parser.Parser)
+	defer gnp.pool.Put(p)
+	sciNameNode := p.PreprocessAndParse(
 		s, ver, gnp.cfg.IgnoreHTMLTags, gnp.cfg.WithCapitalization, gnp.cfg.WithCultivars, gnp.cfg.WithPreserveDiaereses,
+		gnp.cfg.Code,
 	)
-	res := sciNameNode.ToOutput(gnp.cfg.WithDetails)
+	res := sciNameNode.ToOutput(gnp.cfg.detailsRequired())
 	return res
 }
 
 // ParseNames function takes input names and returns parsed results.
 func (gnp gnparser) ParseNames(names []string) []parsed.Parsed {
-	res := make([]parsed.Parsed, len(names))
+	ps, _ := gnp.ParseNamesCtx(context.Background(), names)
+	res := make([]parsed.Parsed, len(ps))
+	for i := range ps {
+		res[i] = ps[i].Parsed
+	}
+	return res
+}
+
+// ParseNamesCtx function takes a context and input names, and returns
+// parsed results with positions of their name-strings in the input.
+// Every unique name-string is parsed only once. If the context is
+// canceled, parsing stops and the context's error is returned.
+func (gnp gnparser) ParseNamesCtx(
+	ctx context.Context,
+	names []string,
+) ([]parsed.ParsedWithIdx, error) {
+	res := make([]parsed.ParsedWithIdx, len(names))
 	jobsNum := gnp.cfg.JobsNum
 	chOut := make(chan parsed.ParsedWithIdx)
 	var wgIn, wgOut sync.WaitGroup
 	wgIn.Add(jobsNum)
 	wgOut.Add(1)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	chIn := loadNames(ctx, names)
+	uniq, positions := dedupNames(names)
+	chIn := loadNames(ctx, uniq)
 
 	for i := jobsNum; i > 0; i-- {
 		go gnp.parseWorker(ctx, chIn, chOut, &wgIn)
@@ -75,19 +126,14 @@ func (gnp gnparser) ParseNames(names []string) []parsed.Parsed {
 	go func() {
 		defer wgOut.Done()
 		var count int
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case v, ok := <-chOut:
-				if !ok {
-					return
-				}
+		for v := range chOut {
+			for _, idx := range positions[v.Idx] {
+				p := parsed.ParsedWithIdx{Idx: idx, Parsed: v.Parsed}
 				if gnp.cfg.WithNoOrder {
-					res[count] = v.Parsed
+					res[count] = p
 					count++
 				} else {
-					res[v.Idx] = v.Parsed
+					res[idx] = p
 				}
 			}
 		}
@@ -96,7 +142,10 @@ func (gnp gnparser) ParseNames(names []string) []parsed.Parsed {
 	wgIn.Wait()
 	close(chOut)
 	wgOut.Wait()
-	return res
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // Format returns the configured output format value.
@@ -104,15 +153,33 @@ func (gnp gnparser) Format() gnfmt.Format {
 	return gnp.cfg.Format
 }
 
+// Fields returns the configured columns of CSV and TSV outputs.
+func (gnp gnparser) Fields() []parsed.Field {
+	return gnp.cfg.Fields
+}
+
 // ChangeConfig allows change configuration of already created
 // GNparser object.
 func (gnp gnparser) ChangeConfig(opts ...Option) GNparser {
+	cacheSize := gnp.cfg.CacheSize
 	for i := range opts {
 		opts[i](&gnp.cfg)
 	}
+	if gnp.cfg.CacheSize != cacheSize {
+		gnp.cache = newCache(gnp.cfg.CacheSize)
+	}
 	return gnp
 }
 
+// CacheStats returns the usage statistics of the cache of parsed results.
+// If the cache is disabled, all values are zero.
+func (gnp gnparser) CacheStats() CacheStats {
+	if gnp.cache == nil {
+		return CacheStats{}
+	}
+	return gnp.cache.stats()
+}
+
 // Version function returns version number of `gnparser` and the timestamp
 // of its build.
 func (gnp gnparser) GetVersion() gnvers.Version {
@@ -131,16 +198,41 @@ func (gnp gnparser) parseWorker(
 	wgIn *sync.WaitGroup,
 ) {
 	defer wgIn.Done()
-	gnp.parser = parser.New()
 
-	for v := range chIn {
-		parseRes := gnp.ParseName(v.NameString)
+	for {
 		select {
 		case <-ctx.Done():
 			return
-		case chOut <- parsed.ParsedWithIdx{Idx: v.Index, Parsed: parseRes}:
+		case v, ok := <-chIn:
+			if !ok {
+				return
+			}
+			parseRes := gnp.ParseName(v.NameString)
+			select {
+			case <-ctx.Done():
+				return
+			case chOut <- parsed.ParsedWithIdx{Idx: v.Index, Parsed: parseRes}:
+			}
+		}
+	}
+}
+
+// dedupNames returns unique name-strings, and positions of every unique
+// name-string in the original slice.
+func dedupNames(names []string) ([]string, [][]int) {
+	idx := make(map[string]int, len(names))
+	uniq := make([]string, 0, len(names))
+	positions := make([][]int, 0, len(names))
+	for i := range names {
+		if j, ok := idx[names[i]]; ok {
+			positions[j] = append(positions[j], i)
+			continue
 		}
+		idx[names[i]] = len(uniq)
+		uniq = append(uniq, names[i])
+		positions = append(positions, []int{i})
 	}
+	return uniq, positions
 }
 
 func loadNames(ctx context.Context, names []string) <-chan nameidx.NameIdx {
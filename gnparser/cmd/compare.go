@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/spf13/cobra"
+)
+
+// compareCmd compares two name-strings.
+var compareCmd = &cobra.Command{
+	Use:   "compare name1 name2",
+	Short: "Compares two scientific names.",
+	Long: `
+Compares two scientific names and shows the strongest level at which they
+are the same (verbatim, normalized, full, simple or stemmed canonical form,
+genus), and how well their authorships, cardinalities and ranks match.
+
+To compare two names:
+gnparser compare "Aus bus (L.) Mill." "Aus bus (Linnaeus) Miller, 1768"
+
+To compare two botanical names with pretty output:
+gnparser compare "Aus bus f. alba" "Aus bus var. alba" --code bot -f pretty
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts = append(opts, gnparser.OptWithDetails(true))
+		codeFlag(cmd)
+		gnp := gnparser.New(gnparser.NewConfig(opts...))
+
+		a := gnp.ParseName(args[0])
+		b := gnp.ParseName(args[1])
+		res := parsed.Compare(a, b)
+
+		f, _ := cmd.Flags().GetString("format")
+		enc := gnfmt.GNjson{Pretty: f == "pretty"}
+		out, err := enc.Encode(res)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		fmt.Println(string(out))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(compareCmd)
+
+	compareCmd.Flags().StringP("format", "f", "",
+		"sets output format. Can be one of:\n  'compact', 'pretty'")
+
+	codeHelp := "sets nomenclatural code of names to resolve ambiguities.\n" +
+		"Can be one of: 'zoo', 'bot', 'bac', 'vir', 'cult'\n" +
+		"(or ICZN, ICN, ICNP, ICVCN, ICNCP)"
+	compareCmd.Flags().String("code", "", codeHelp)
+}
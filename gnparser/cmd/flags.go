@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/spf13/cobra"
 )
 
@@ -33,6 +36,23 @@ func formatFlag(cmd *cobra.Command) {
 	}
 }
 
+func fieldsFlag(cmd *cobra.Command) {
+	s, err := cmd.Flags().GetString("fields")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if s == "" {
+		return
+	}
+	fields, err := parsed.NewFields(s)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	opts = append(opts, gnparser.OptFields(fields))
+}
+
 func jobsNumFlag(cmd *cobra.Command) {
 	jn, err := cmd.Flags().GetInt("jobs")
 	if err != nil {
@@ -110,6 +130,23 @@ func withEnableCultivarsFlag(cmd *cobra.Command) {
 	}
 }
 
+func codeFlag(cmd *cobra.Command) {
+	s, err := cmd.Flags().GetString("code")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if s == "" {
+		return
+	}
+	code := nomcode.New(s)
+	if code == nomcode.Unknown {
+		fmt.Printf("Unknown nomenclatural code '%s'.\n", s)
+		os.Exit(1)
+	}
+	opts = append(opts, gnparser.OptCode(code))
+}
+
 func withStreamFlag(cmd *cobra.Command) {
 	withDet, err := cmd.Flags().GetBool("stream")
 	if err != nil {
@@ -132,6 +169,48 @@ func batchSizeFlag(cmd *cobra.Command) {
 	}
 }
 
+func inputFlags(cmd *cobra.Command) {
+	f, err := cmd.Flags().GetString("input_format")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	switch strings.ToLower(f) {
+	case "", "text", "txt":
+		input.format = textInput
+	case "csv":
+		input.format = csvInput
+	case "tsv":
+		input.format = tsvInput
+	default:
+		fmt.Printf("Unknown input format '%s'.\n", f)
+		os.Exit(1)
+	}
+
+	input.nameField, err = cmd.Flags().GetString("name_field")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	input.idField, err = cmd.Flags().GetString("id_field")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	input.keepColumns, err = cmd.Flags().GetBool("keep_columns")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	hasColumns := input.nameField != "" || input.idField != "" ||
+		input.keepColumns
+	if input.format == textInput && hasColumns {
+		fmt.Println("Column settings require '--input_format csv' or 'tsv'.")
+		os.Exit(1)
+	}
+}
+
 func portFlag(cmd *cobra.Command) int {
 	webPort, err := cmd.Flags().GetInt("port")
 	if err != nil {
@@ -143,3 +222,12 @@ func portFlag(cmd *cobra.Command) int {
 	}
 	return webPort
 }
+
+func grpcPortFlag(cmd *cobra.Command) int {
+	port, err := cmd.Flags().GetInt("grpc_port")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return port
+}
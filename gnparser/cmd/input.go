@@ -0,0 +1,296 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// inputFormat determines how name-strings are read from a file or STDIN.
+type inputFormat int
+
+const (
+	// textInput contains one name-string per line.
+	textInput inputFormat = iota
+	// csvInput is a comma-separated file with a header row.
+	csvInput
+	// tsvInput is a tab-separated file with a header row.
+	tsvInput
+)
+
+// inputOpts contain settings for reading name-strings from CSV or TSV
+// files.
+type inputOpts struct {
+	// format of the input.
+	format inputFormat
+
+	// nameField is a header or a 1-based index of the name-strings column.
+	// If it is empty, a column called 'scientificName' or 'name' is used.
+	nameField string
+
+	// idField is a header or a 1-based index of the column with IDs of
+	// the records. IDs are copied in front of the parsed columns.
+	idField string
+
+	// keepColumns, when true, copies all input columns in front of the
+	// parsed columns.
+	keepColumns bool
+}
+
+// record is a name-string together with input columns that have to be
+// copied to the output.
+type record struct {
+	name    string
+	columns []string
+}
+
+// recordReader reads records either from lines of a text, or from rows of
+// a CSV or TSV file.
+type recordReader struct {
+	sc      *bufio.Scanner
+	csv     *csv.Reader
+	header  []string
+	nameIdx int
+	keepIdx []int
+	err     error
+}
+
+// newRecordReader creates a recordReader. For CSV and TSV inputs it
+// reads the header row and finds the name-strings and ID columns.
+func newRecordReader(f io.Reader, in inputOpts) (*recordReader, error) {
+	res := recordReader{}
+	if in.format == textInput {
+		res.sc = bufio.NewScanner(f)
+		return &res, nil
+	}
+
+	res.csv = csv.NewReader(f)
+	res.csv.FieldsPerRecord = -1
+	res.csv.LazyQuotes = true
+	if in.format == tsvInput {
+		res.csv.Comma = '\t'
+	}
+
+	header, err := res.csv.Read()
+	if err == io.EOF {
+		return nil, errors.New("input file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.header = header
+
+	res.nameIdx, err = columnIdx(header, in.nameField, "scientificName", "name")
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case in.keepColumns:
+		res.keepIdx = make([]int, len(header))
+		for i := range header {
+			res.keepIdx[i] = i
+		}
+	case in.idField != "":
+		idx, err := columnIdx(header, in.idField)
+		if err != nil {
+			return nil, err
+		}
+		res.keepIdx = []int{idx}
+	}
+	return &res, nil
+}
+
+// columnIdx returns the position of a column given by its header or by
+// its 1-based index. If the field is empty, the first found of default
+// headers is used. Headers are case-insensitive.
+func columnIdx(header []string, field string, defaults ...string) (int, error) {
+	if field == "" {
+		for _, v := range defaults {
+			if idx, ok := headerIdx(header, v); ok {
+				return idx, nil
+			}
+		}
+		return 0, fmt.Errorf("cannot find %s column in the header",
+			strings.Join(defaults, " or "))
+	}
+
+	if idx, ok := headerIdx(header, field); ok {
+		return idx, nil
+	}
+	if i, err := strconv.Atoi(field); err == nil {
+		if i < 1 || i > len(header) {
+			return 0, fmt.Errorf("column %d is out of range 1-%d", i, len(header))
+		}
+		return i - 1, nil
+	}
+	return 0, fmt.Errorf("cannot find '%s' column in the header", field)
+}
+
+func headerIdx(header []string, field string) (int, bool) {
+	for i, v := range header {
+		if strings.EqualFold(strings.TrimSpace(v), field) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// next returns the next record of the input. It returns false when the
+// input is exhausted or an error happened.
+func (rr *recordReader) next() (record, bool) {
+	if rr.sc != nil {
+		if !rr.sc.Scan() {
+			rr.err = rr.sc.Err()
+			return record{}, false
+		}
+		return record{name: rr.sc.Text()}, true
+	}
+
+	row, err := rr.csv.Read()
+	if err != nil {
+		if err != io.EOF {
+			rr.err = err
+		}
+		return record{}, false
+	}
+	return record{
+		name:    column(row, rr.nameIdx),
+		columns: rr.keptColumns(row),
+	}, true
+}
+
+// Err returns the first error, except io.EOF, that happened during
+// reading of the input.
+func (rr *recordReader) Err() error {
+	return rr.err
+}
+
+// keptColumns returns columns of a row that are copied to the output.
+func (rr *recordReader) keptColumns(row []string) []string {
+	if len(rr.keepIdx) == 0 {
+		return nil
+	}
+	res := make([]string, len(rr.keepIdx))
+	for i, v := range rr.keepIdx {
+		res[i] = column(row, v)
+	}
+	return res
+}
+
+// column returns a column of a row, or an empty string if the row is
+// too short.
+func column(row []string, idx int) string {
+	if idx < len(row) {
+		return row[idx]
+	}
+	return ""
+}
+
+// headerOutput returns the header of the output, if the output format
+// has one. Headers of copied input columns precede parsed columns. If
+// a copied header is the same as one of the parsed headers, it gets
+// 'input_' prefix.
+func (rr *recordReader) headerOutput(
+	f gnfmt.Format,
+	fields []parsed.Field,
+) string {
+	header := parsed.HeaderCSV(f, fields...)
+	if header == "" || len(rr.keepIdx) == 0 {
+		return header
+	}
+
+	r := csv.NewReader(strings.NewReader(header))
+	r.Comma = outputSep(f)
+	parsedHeader, _ := r.Read()
+	kept := rr.keptColumns(rr.header)
+	for i, v := range kept {
+		if _, ok := headerIdx(parsedHeader, strings.TrimSpace(v)); ok {
+			kept[i] = "input_" + v
+		}
+	}
+	return gnfmt.ToCSV(kept, outputSep(f)) + string(outputSep(f)) + header
+}
+
+// recordOutput returns parsed results of a record. Copied input columns
+// precede parsed columns in CSV, TSV and DwC outputs. JSON outputs
+// contain them as an 'input' object.
+func (rr *recordReader) recordOutput(
+	p parsed.Parsed,
+	rec record,
+	f gnfmt.Format,
+	fields []parsed.Field,
+) string {
+	if len(rec.columns) == 0 {
+		return p.Output(f, fields...)
+	}
+
+	switch f {
+	case gnfmt.CompactJSON, gnfmt.PrettyJSON:
+		input := inputColumns{
+			header: rr.keptColumns(rr.header),
+			values: rec.columns,
+		}
+		res := parsedWithInput{Input: input, Parsed: p}
+		enc := gnfmt.GNjson{Pretty: f == gnfmt.PrettyJSON}
+		bs, _ := enc.Encode(res)
+		return string(bs)
+	default:
+		sep := outputSep(f)
+		return gnfmt.ToCSV(rec.columns, sep) + string(sep) +
+			p.Output(f, fields...)
+	}
+}
+
+// parsedWithInput is a JSON output of parsed results together with
+// copied input columns.
+type parsedWithInput struct {
+	Input inputColumns `json:"input"`
+	parsed.Parsed
+}
+
+// inputColumns are copied input columns. They are converted to a JSON
+// object with keys in the order of the input header.
+type inputColumns struct {
+	header, values []string
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (ic inputColumns) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i := range ic.header {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(ic.header[i])
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(column(ic.values, i))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func outputSep(f gnfmt.Format) rune {
+	if f == gnfmt.TSV {
+		return '\t'
+	}
+	return ','
+}
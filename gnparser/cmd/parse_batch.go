@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"bufio"
 	"fmt"
-	"io"
 	"log"
 	"sync"
 	"time"
@@ -13,57 +11,81 @@ import (
 	"github.com/gnames/gnparser/ent/parsed"
 )
 
+// batchResult contains parsed results of a batch together with the
+// records the name-strings came from.
+type batchResult struct {
+	records []record
+	parsed  []parsed.Parsed
+}
+
 func parseBatch(
 	gnp gnparser.GNparser,
-	f io.Reader,
+	rr *recordReader,
 	quiet bool,
 ) {
+	// copied input columns can be matched with parsed results only if
+	// results keep the order of the input.
+	if len(rr.keepIdx) > 0 {
+		gnp = gnp.ChangeConfig(gnparser.OptWithNoOrder(false))
+	}
+
 	batch := make([]string, batchSize)
-	chOut := make(chan []parsed.Parsed)
+	records := make([]record, batchSize)
+	chOut := make(chan batchResult)
 	start := time.Now()
 	var wg sync.WaitGroup
 
 	wg.Add(1)
-	go processResults(chOut, &wg, gnp.Format())
+	go processResults(rr, chOut, &wg, gnp.Format(), gnp.Fields())
 
-	sc := bufio.NewScanner(f)
 	var i, count int
-	for sc.Scan() {
-		batch[count] = sc.Text()
+	for {
+		rec, ok := rr.next()
+		if !ok {
+			break
+		}
+		batch[count] = rec.name
+		records[count] = rec
 		count++
 		if count == batchSize {
 			i++
 			if !quiet {
 				progressLog(start, count*i)
 			}
-			chOut <- gnp.ParseNames(batch)
+			chOut <- batchResult{records: records, parsed: gnp.ParseNames(batch)}
 			batch = make([]string, batchSize)
+			records = make([]record, batchSize)
 			count = 0
 		}
 	}
-	chOut <- gnp.ParseNames(batch[:count])
-	close(chOut)
-	if err := sc.Err(); err != nil {
-		log.Panic(err)
+	chOut <- batchResult{
+		records: records[:count],
+		parsed:  gnp.ParseNames(batch[:count]),
 	}
+	close(chOut)
 	wg.Wait()
+	if err := rr.Err(); err != nil {
+		log.Fatalf("Cannot read input: %s", err)
+	}
 }
 
 func processResults(
-	out <-chan []parsed.Parsed,
+	rr *recordReader,
+	out <-chan batchResult,
 	wg *sync.WaitGroup,
 	f gnfmt.Format,
+	fields []parsed.Field,
 ) {
 	defer wg.Done()
 
-	header := parsed.HeaderCSV(f)
+	header := rr.headerOutput(f, fields)
 	if header != "" {
 		fmt.Println(header)
 	}
 
-	for pr := range out {
-		for i := range pr {
-			fmt.Println(pr[i].Output(f))
+	for br := range out {
+		for i := range br.parsed {
+			fmt.Println(rr.recordOutput(br.parsed[i], br.records[i], f, fields))
 		}
 	}
 }
@@ -1,10 +1,8 @@
 package cmd
 
 import (
-	"bufio"
 	"context"
 	"fmt"
-	"io"
 	"log"
 	"sync"
 	"time"
@@ -14,45 +12,76 @@ import (
 	"github.com/gnames/gnparser/ent/parsed"
 )
 
+// streamRecords keeps records with copied input columns until their
+// name-strings are parsed.
+type streamRecords struct {
+	mx   sync.Mutex
+	data map[int]record
+}
+
+func (sr *streamRecords) add(idx int, rec record) {
+	sr.mx.Lock()
+	defer sr.mx.Unlock()
+	sr.data[idx] = rec
+}
+
+// pop returns a record by its index and removes it from the storage.
+func (sr *streamRecords) pop(idx int) record {
+	sr.mx.Lock()
+	defer sr.mx.Unlock()
+	res := sr.data[idx]
+	delete(sr.data, idx)
+	return res
+}
+
 func getNames(
 	ctx context.Context,
-	f io.Reader,
+	rr *recordReader,
+	records *streamRecords,
 ) <-chan nameidx.NameIdx {
 	chIn := make(chan nameidx.NameIdx)
-	sc := bufio.NewScanner(f)
 
 	go func() {
 		defer close(chIn)
 		var count int
-		for sc.Scan() {
-			nameString := sc.Text()
+		for {
+			rec, ok := rr.next()
+			if !ok {
+				break
+			}
+			if len(rec.columns) > 0 {
+				records.add(count, rec)
+			}
 			select {
 			case <-ctx.Done():
 				return
-			case chIn <- nameidx.NameIdx{Index: count, NameString: nameString}:
+			case chIn <- nameidx.NameIdx{Index: count, NameString: rec.name}:
 			}
 			count++
 		}
 	}()
-	if err := sc.Err(); err != nil {
-		log.Panic(err)
-	}
 	return chIn
 }
 
 func parseStream(
 	gnp gnparser.GNparser,
-	f io.Reader,
+	rr *recordReader,
 	quiet bool,
 ) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	chIn := getNames(ctx, f)
-	chOut := make(chan parsed.Parsed)
+	records := &streamRecords{data: make(map[int]record)}
+	chIn := getNames(ctx, rr, records)
+	chOut := make(chan parsed.ParsedWithIdx)
 	var wg sync.WaitGroup
 	wg.Add(1)
 
-	go gnp.ParseNameStream(ctx, chIn, chOut)
+	go func() {
+		err := gnp.ParseNameStreamCtx(ctx, chIn, chOut)
+		if err != nil && err != context.Canceled {
+			log.Println(err)
+		}
+	}()
 
 	// process parsing results
 	go func() {
@@ -60,7 +89,7 @@ func parseStream(
 		defer wg.Done()
 		start := time.Now()
 
-		header := parsed.HeaderCSV(gnp.Format())
+		header := rr.headerOutput(gnp.Format(), gnp.Fields())
 		if header != "" {
 			fmt.Println(header)
 		}
@@ -78,9 +107,15 @@ func parseStream(
 				if !ok {
 					return
 				}
-				fmt.Println(v.Output(gnp.Format()))
+				rec := records.pop(v.Idx)
+				fmt.Println(
+					rr.recordOutput(v.Parsed, rec, gnp.Format(), gnp.Fields()),
+				)
 			}
 		}
 	}()
 	wg.Wait()
+	if err := rr.Err(); err != nil {
+		log.Fatalf("Cannot read input: %s", err)
+	}
 }
@@ -3,6 +3,7 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/gnames/gnparser"
 	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/grpc"
 	"github.com/gnames/gnparser/io/web"
 	"github.com/gnames/gnsys"
 	"github.com/spf13/cobra"
@@ -20,6 +22,7 @@ const debug = false
 var (
 	opts      []gnparser.Option
 	batchSize int
+	input     inputOpts
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -42,18 +45,42 @@ gnparser "Homo sapiens Linnaeus 1758" -f compact [flags]
 or
 gnparser "Homo sapiens Linnaeus 1758" -f pretty [flags]
 
+To parse one name into selected CSV columns:
+gnparser "Homo sapiens Linnaeus 1758" --fields id,genus,species,authors
+
+To parse one name into Darwin Core fields:
+gnparser "Homo sapiens Linnaeus 1758" -f dwc
+
 To parse with maximum amount of details:
 gnparser "Homo sapiens Linnaeus 1758" -d -f pretty
 
 To parse many names from a file (one name per line):
 gnparser names.txt [flags] > parsed_names.txt
 
+To parse names from a CSV file, keeping IDs of the records:
+gnparser names.csv --input_format csv --name_field scientificName \
+  --id_field taxonID > parsed_names.csv
+
+To parse names from the 3rd column of a TSV file, keeping all its columns:
+gnparser names.tsv --input_format tsv --name_field 3 --keep_columns \
+  -f tsv > parsed_names.tsv
+
 To leave HTML tags and entities intact when parsing (faster)
 gnparser names.txt -n > parsed_names.txt
 
+To parse botanical names (f. is always 'forma'):
+gnparser names.txt --code bot > parsed_names.txt
+
 To start web service on port 8080 with 5 concurrent jobs:
 gnparser -j 5 -p 8080
+
+To start gRPC service on port 8778:
+gnparser -g 8778
+
+To compare two names:
+gnparser compare "Aus bus (L.) Mill." "Aus bus (Linnaeus) Miller, 1768"
  `,
+	Args: cobra.ArbitraryArgs,
 
 	Run: func(cmd *cobra.Command, args []string) {
 		if versionFlag(cmd) {
@@ -65,6 +92,7 @@ gnparser -j 5 -p 8080
 		}
 
 		formatFlag(cmd)
+		fieldsFlag(cmd)
 		jobsNumFlag(cmd)
 		ignoreHTMLTagsFlag(cmd)
 		withDetailsFlag(cmd)
@@ -73,11 +101,22 @@ gnparser -j 5 -p 8080
 		withCapitalizeFlag(cmd)
 		withEnableCultivarsFlag(cmd)
 		withPreserveDiaeresesFlag(cmd)
+		codeFlag(cmd)
 		batchSizeFlag(cmd)
+		inputFlags(cmd)
 		port := portFlag(cmd)
+		grpcPort := grpcPortFlag(cmd)
 		cfg := gnparser.NewConfig(opts...)
 		batchSize = cfg.BatchSize
 
+		if grpcPort != 0 {
+			gnp := gnparser.New(cfg)
+			if err := grpc.Run(gnp, grpcPort); err != nil {
+				log.Fatal(err)
+			}
+			os.Exit(0)
+		}
+
 		if port != 0 {
 			cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
 			gnp := gnparser.New(cfg)
@@ -123,9 +162,27 @@ func init() {
 	rootCmd.Flags().BoolP("details", "d", false, "provides more details")
 
 	formatHelp := "sets output format. Can be one of:\n  " +
-		"'csv', 'compact', 'pretty'"
+		"'csv', 'tsv', 'compact', 'pretty', 'dwc' (Darwin Core),\n  " +
+		"'html', 'markdown' (names with italics)"
 	rootCmd.Flags().StringP("format", "f", "", formatHelp)
 
+	fieldsHelp := "sets columns of CSV/TSV output, for example\n" +
+		"'id,verbatim,genus,species,infraspecies,rank,authors,warnings'"
+	rootCmd.Flags().String("fields", "", fieldsHelp)
+
+	rootCmd.Flags().String("input_format", "",
+		"sets format of input file: 'text' (default), 'csv' or 'tsv'.")
+
+	rootCmd.Flags().String("name_field", "",
+		"header or 1-based index of CSV/TSV column with names.\n"+
+			"Default is 'scientificName' or 'name' column.")
+
+	rootCmd.Flags().String("id_field", "",
+		"header or 1-based index of CSV/TSV column with IDs to copy to output.")
+
+	rootCmd.Flags().Bool("keep_columns", false,
+		"copy all columns of CSV/TSV input in front of parsed columns.")
+
 	rootCmd.Flags().BoolP("ignore_tags", "i", false,
 		"ignore HTML entities and tags when parsing.")
 
@@ -135,6 +192,9 @@ func init() {
 	rootCmd.Flags().IntP("port", "p", 0,
 		"starts web site and REST server on the port.")
 
+	rootCmd.Flags().IntP("grpc_port", "g", 0,
+		"starts gRPC server on the port.")
+
 	rootCmd.Flags().BoolP("quiet", "q", false, "do not show progress")
 
 	rootCmd.Flags().BoolP("stream", "s", false,
@@ -152,6 +212,11 @@ func init() {
 	rootCmd.Flags().BoolP("diaereses", "D", false,
 		"preserve diaereses in names")
 
+	codeHelp := "sets nomenclatural code of names to resolve ambiguities.\n" +
+		"Can be one of: 'zoo', 'bot', 'bac', 'vir', 'cult'\n" +
+		"(or ICZN, ICN, ICNP, ICVCN, ICNCP)"
+	rootCmd.Flags().String("code", "", codeHelp)
+
 }
 
 func processStdin(cmd *cobra.Command, cfg gnparser.Config, quiet bool) {
@@ -160,11 +225,27 @@ func processStdin(cmd *cobra.Command, cfg gnparser.Config, quiet bool) {
 		return
 	}
 	gnp := gnparser.New(cfg)
+	parseInput(gnp, os.Stdin, cfg.WithStream, quiet)
+}
 
-	if cfg.WithStream {
-		parseStream(gnp, os.Stdin, quiet)
+// parseInput parses name-strings from a file or STDIN according to input
+// settings.
+func parseInput(gnp gnparser.GNparser, f io.Reader, stream, quiet bool) {
+	rr, err := newRecordReader(f, input)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if len(rr.keepIdx) > 0 &&
+		(gnp.Format() == parsed.HTML || gnp.Format() == parsed.Markdown) {
+		fmt.Println("Input columns cannot be copied to html or markdown formats.")
+		os.Exit(1)
+	}
+
+	if stream {
+		parseStream(gnp, rr, quiet)
 	} else {
-		parseBatch(gnp, os.Stdin, quiet)
+		parseBatch(gnp, rr, quiet)
 	}
 }
 
@@ -213,11 +294,7 @@ func parse(
 			log.Fatal(err)
 			os.Exit(1)
 		}
-		if cfg.WithStream {
-			parseStream(gnp, f, quiet)
-		} else {
-			parseBatch(gnp, f, quiet)
-		}
+		parseInput(gnp, f, cfg.WithStream, quiet)
 		f.Close()
 	} else {
 		parseString(gnp, data)
@@ -228,12 +305,12 @@ func parseString(gnp gnparser.GNparser, name string) {
 	res := gnp.ParseName(name)
 	f := gnp.Format()
 
-	header := parsed.HeaderCSV(f)
+	header := parsed.HeaderCSV(f, gnp.Fields()...)
 	if header != "" {
 		fmt.Println(header)
 	}
 
-	fmt.Println(res.Output(f))
+	fmt.Println(res.Output(f, gnp.Fields()...))
 }
 
 func progressLog(start time.Time, namesNum int) {
@@ -36,6 +36,41 @@ func TestFormat(t *testing.T) {
 		assert.Contains(t, c.Stdout(), ",Homo sapiens,2")
 	})
 
+	t.Run("runs csv format with fields", func(t *testing.T) {
+		c := testcli.Command("gnparser", "Homo sapiens L.", "--fields",
+			"genus,species,authors")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Equal(t, c.Stdout(), "Genus,Species,Authors\nHomo,sapiens,L.\n")
+	})
+
+	t.Run("fails with unknown fields", func(t *testing.T) {
+		c := testcli.Command("gnparser", "Homo sapiens", "--fields", "genera")
+		c.Run()
+		assert.False(t, c.Success())
+		assert.Contains(t, c.Stdout(), "unknown fields: genera")
+	})
+
+	t.Run("runs dwc format", func(t *testing.T) {
+		c := testcli.Command("gnparser", "Homo sapiens L. 1758", "-f", "dwc")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), "id,scientificName,")
+		assert.Contains(t, c.Stdout(), ",Homo,,sapiens,,species,,1758,")
+	})
+
+	t.Run("runs html and markdown formats", func(t *testing.T) {
+		c := testcli.Command("gnparser", "Aus bus L. var. cus", "-f", "html")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Equal(t, c.Stdout(), "<i>Aus bus</i> L. var. <i>cus</i>\n")
+
+		c = testcli.Command("gnparser", "Aus bus L. var. cus", "-f", "markdown")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Equal(t, c.Stdout(), "*Aus bus* L. var. *cus*\n")
+	})
+
 	t.Run("ignores parsing with --version", func(t *testing.T) {
 		c := testcli.Command("gnparser", "Homo sapiens", "-f", "simple", "--version")
 		c.Run()
@@ -70,3 +105,116 @@ func TestStdin(t *testing.T) {
 		assert.Contains(t, c.Stdout(), ",Bubo,")
 	})
 }
+
+func TestInputCSV(t *testing.T) {
+	data := "taxonID,scientificName,kingdom\n" +
+		"1,Homo sapiens L.,Animalia\n" +
+		"2,\"Bubo bubo (Linnaeus, 1758)\",Animalia\n" +
+		"3,Pinus alba,Plantae\n"
+
+	t.Run("copies IDs to output", func(t *testing.T) {
+		for _, v := range []string{"", "-s"} {
+			args := []string{"--input_format", "csv", "--id_field", "taxonID",
+				"--fields", "simple", "-q"}
+			if v != "" {
+				args = append(args, v)
+			}
+			c := testcli.Command("gnparser", args...)
+			c.SetStdin(strings.NewReader(data))
+			c.Run()
+			assert.True(t, c.Success())
+			assert.Equal(t, c.Stdout(), "taxonID,CanonicalSimple\n"+
+				"1,Homo sapiens\n2,Bubo bubo\n3,Pinus alba\n", v)
+		}
+	})
+
+	t.Run("copies all columns to output", func(t *testing.T) {
+		c := testcli.Command("gnparser", "--input_format", "tsv",
+			"--name_field", "2", "--keep_columns", "--fields", "genus", "-f", "tsv")
+		c.SetStdin(strings.NewReader(
+			"id\tname\trank\n1\tAus bus\tspecies\n2\tCus\tgenus\n",
+		))
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Equal(t, c.Stdout(), "id\tname\trank\tGenus\n"+
+			"1\tAus bus\tspecies\tAus\n2\tCus\tgenus\t\n")
+	})
+
+	t.Run("keeps order of input columns in JSON", func(t *testing.T) {
+		c := testcli.Command("gnparser", "--input_format", "csv",
+			"--keep_columns", "-f", "compact", "-q")
+		c.SetStdin(strings.NewReader(data))
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), `{"input":{"taxonID":"2",`+
+			`"scientificName":"Bubo bubo (Linnaeus, 1758)","kingdom":"Animalia"},`)
+	})
+
+	t.Run("renames repeated headers", func(t *testing.T) {
+		c := testcli.Command("gnparser", "--input_format", "csv",
+			"--keep_columns", "-f", "dwc", "-q")
+		c.SetStdin(strings.NewReader("id,name\n1,Aus bus\n"))
+		c.Run()
+		assert.True(t, c.Success())
+		assert.True(t, strings.HasPrefix(c.Stdout(), "input_id,name,id,"))
+	})
+
+	t.Run("reads stray quotes in CSV", func(t *testing.T) {
+		c := testcli.Command("gnparser", "--input_format", "csv",
+			"--id_field", "id", "--fields", "simple", "-q")
+		c.SetStdin(strings.NewReader("id,name\n1,Aus \"bus\n2,Cus dus\n"))
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), "2,Cus dus\n")
+	})
+
+	t.Run("fails to copy columns to html", func(t *testing.T) {
+		c := testcli.Command("gnparser", "--input_format", "csv",
+			"--id_field", "taxonID", "-f", "html")
+		c.SetStdin(strings.NewReader(data))
+		c.Run()
+		assert.False(t, c.Success())
+		assert.Contains(t, c.Stdout(), "cannot be copied to html")
+	})
+
+	t.Run("fails with unknown name column", func(t *testing.T) {
+		c := testcli.Command("gnparser", "--input_format", "csv",
+			"--name_field", "name_string")
+		c.SetStdin(strings.NewReader(data))
+		c.Run()
+		assert.False(t, c.Success())
+		assert.Contains(t, c.Stdout(), "cannot find 'name_string' column")
+	})
+
+	t.Run("fails on unreadable input", func(t *testing.T) {
+		c := testcli.Command("gnparser", "-q")
+		c.SetStdin(strings.NewReader("Aus bus\n" + strings.Repeat("A", 70_000)))
+		c.Run()
+		assert.False(t, c.Success())
+		assert.Contains(t, c.Stderr(), "Cannot read input")
+	})
+}
+
+func TestCompare(t *testing.T) {
+	t.Run("compares two names", func(t *testing.T) {
+		c := testcli.Command("gnparser", "compare", "Aus bus (L.) Mill.",
+			"Aus bus (Linnaeus) Miller, 1768")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), `"level":"FULL"`)
+		assert.Contains(t, c.Stdout(), `"grade":"COMPATIBLE"`)
+	})
+
+	t.Run("needs two names", func(t *testing.T) {
+		c := testcli.Command("gnparser", "compare", "Aus bus")
+		c.Run()
+		assert.False(t, c.Success())
+	})
+
+	t.Run("still parses a name", func(t *testing.T) {
+		c := testcli.Command("gnparser", "Homo sapiens", "-f", "csv")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), ",Homo sapiens,2")
+	})
+}
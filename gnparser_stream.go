@@ -2,45 +2,88 @@ package gnparser
 
 import (
 	"context"
-	"log"
 	"sync"
 
 	"github.com/gnames/gnparser/ent/nameidx"
 	"github.com/gnames/gnparser/ent/parsed"
-	"github.com/gnames/gnparser/ent/parser"
 	"github.com/gnames/organizer"
 )
 
 // ParseNameStream takes an input channel of input.Name and
 // returns back a stream of parsed data following the same order as
-// the input.
+// the input. The output channel is closed when parsing is done.
+// Use ParseNameStreamCtx to get errors and original positions of
+// names.
 func (gnp gnparser) ParseNameStream(
 	ctx context.Context,
 	chIn <-chan nameidx.NameIdx,
 	chOut chan<- parsed.Parsed,
 ) {
+	defer close(chOut)
+	chRes := make(chan parsed.ParsedWithIdx)
+	go func() {
+		_ = gnp.ParseNameStreamCtx(ctx, chIn, chRes)
+	}()
+
+	for v := range chRes {
+		select {
+		case <-ctx.Done():
+			return
+		case chOut <- v.Parsed:
+		}
+	}
+}
+
+// ParseNameStreamCtx takes an input channel of names with their
+// positions and sends parsed results with the same positions to the
+// output channel. If WithNoOrder is false, results follow the order of
+// the input. The output channel is closed when parsing is done.
+// It returns an error if the context was canceled, or if results could not
+// be assembled.
+func (gnp gnparser) ParseNameStreamCtx(
+	ctx context.Context,
+	chIn <-chan nameidx.NameIdx,
+	chOut chan<- parsed.ParsedWithIdx,
+) error {
+	defer close(chOut)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	chUnordered := make(chan organizer.Ordered)
-	chOrdered := make(chan organizer.Ordered)
-	var wgWorker, wgOutput sync.WaitGroup
+	var wgWorker sync.WaitGroup
 	jobs := gnp.cfg.JobsNum
 	wgWorker.Add(jobs)
-	wgOutput.Add(1)
 
 	for i := jobs; i > 0; i-- {
 		go gnp.parseStreamWorker(ctx, chIn, chUnordered, &wgWorker)
 	}
 
-	if gnp.cfg.WithNoOrder {
-		close(chOrdered)
-		go sendUnordered(ctx, chUnordered, chOut, &wgOutput)
-	} else {
-		go organizer.Organize(ctx, chUnordered, chOrdered)
-		go sendOrdered(ctx, chOrdered, chOut, &wgOutput)
+	go func() {
+		wgWorker.Wait()
+		close(chUnordered)
+	}()
+
+	chRes := chUnordered
+	chErr := make(chan error, 1)
+	if !gnp.cfg.WithNoOrder {
+		chOrdered := make(chan organizer.Ordered)
+		go func() {
+			chErr <- organizer.Organize(ctx, chUnordered, chOrdered)
+		}()
+		chRes = chOrdered
 	}
 
+	err := sendResults(ctx, chRes, chOut)
+	if err == nil {
+		err = ctx.Err()
+	}
+	cancel()
 	wgWorker.Wait()
-	close(chUnordered)
-	wgOutput.Wait()
+
+	if err == nil && !gnp.cfg.WithNoOrder {
+		err = <-chErr
+	}
+	return err
 }
 
 func (gnp gnparser) parseStreamWorker(
@@ -50,57 +93,47 @@ func (gnp gnparser) parseStreamWorker(
 	wg *sync.WaitGroup,
 ) {
 	defer wg.Done()
-	gnp.parser = parser.New()
-	for v := range chIn {
-		parseRes := gnp.ParseName(v.NameString)
-		select {
-		case <-ctx.Done():
-			return
-		case chOut <- parsed.ParsedWithIdx{Parsed: parseRes, Error: nil, Idx: v.Index}:
-		}
-	}
-}
-
-func sendOrdered(
-	ctx context.Context,
-	chOrdered <-chan organizer.Ordered,
-	chOut chan<- parsed.Parsed,
-	wg *sync.WaitGroup,
-) {
-	defer wg.Done()
-	for v := range chOrdered {
-		var p parsed.Parsed
-		err := v.Unpack(&p)
-		if err != nil {
-			log.Panic(err)
-		}
+	for {
 		select {
 		case <-ctx.Done():
 			return
-		case chOut <- p:
+		case v, ok := <-chIn:
+			if !ok {
+				return
+			}
+			parseRes := gnp.ParseName(v.NameString)
+			select {
+			case <-ctx.Done():
+				return
+			case chOut <- parsed.ParsedWithIdx{Parsed: parseRes, Error: nil, Idx: v.Index}:
+			}
 		}
 	}
-	close(chOut)
 }
 
-func sendUnordered(
+func sendResults(
 	ctx context.Context,
-	chUnordered <-chan organizer.Ordered,
-	chOut chan<- parsed.Parsed,
-	wg *sync.WaitGroup,
-) {
-	defer wg.Done()
-	for v := range chUnordered {
-		var p parsed.Parsed
-		err := v.Unpack(&p)
-		if err != nil {
-			log.Panic(err)
-		}
+	chRes <-chan organizer.Ordered,
+	chOut chan<- parsed.ParsedWithIdx,
+) error {
+	for {
 		select {
 		case <-ctx.Done():
-			return
-		case chOut <- p:
+			return ctx.Err()
+		case v, ok := <-chRes:
+			if !ok {
+				return nil
+			}
+			var p parsed.Parsed
+			err := v.Unpack(&p)
+			if err != nil {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case chOut <- parsed.ParsedWithIdx{Idx: v.Index(), Parsed: p}:
+			}
 		}
 	}
-	close(chOut)
 }
@@ -2,13 +2,17 @@ package gnparser_test
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/nameidx"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnsys"
 	"github.com/stretchr/testify/assert"
@@ -50,6 +54,246 @@ func TestParseNameCultivars(t *testing.T) {
 	}
 }
 
+func TestParsedRoundTrip(t *testing.T) {
+	enc := gnfmt.GNjson{}
+	for _, file := range []string{"test_data.md", "test_data_cultivars.md"} {
+		data := getTestData(t, file)
+		for _, v := range data {
+			var res parsed.Parsed
+			err := enc.Decode([]byte(v.jsonData), &res)
+			assert.Nil(t, err, v.name)
+			json := res.Output(gnfmt.CompactJSON)
+			assert.Equal(t, json, v.jsonData, v.name)
+		}
+	}
+}
+
+func TestParsedDecodeDetails(t *testing.T) {
+	enc := gnfmt.GNjson{}
+	cfg := gnparser.NewConfig(
+		gnparser.OptWithDetails(true),
+		gnparser.OptWithCultivars(true),
+	)
+	gnp := gnparser.New(cfg)
+	tests := []struct {
+		msg, name string
+		details   parsed.Details
+	}{
+		{"uninomial", "Homo L.", parsed.DetailsUninomial{}},
+		{"species", "Homo sapiens L.", parsed.DetailsSpecies{}},
+		{"infrasp", "Aus bus var. cus", parsed.DetailsInfraspecies{}},
+		{"comparison", "Aus cf. bus", parsed.DetailsComparison{}},
+		{"approx", "Aus sp. 1", parsed.DetailsApproximation{}},
+		{"virus", "Tobacco mosaic virus", parsed.DetailsVirus{}},
+		{"hybrid", "Aus bus × Cus dus", parsed.DetailsHybridFormula{}},
+		{"graft", "Aus bus + Cus dus", parsed.DetailsGraftChimeraFormula{}},
+		{"not parsed", "Not a name", nil},
+	}
+	for _, v := range tests {
+		p := gnp.ParseName(v.name)
+		json := p.Output(gnfmt.CompactJSON)
+		var res parsed.Parsed
+		err := enc.Decode([]byte(json), &res)
+		assert.Nil(t, err, v.msg)
+		assert.IsType(t, v.details, res.Details, v.msg)
+		assert.Equal(t, res.Output(gnfmt.CompactJSON), json, v.msg)
+	}
+
+	var res parsed.Parsed
+	err := enc.Decode([]byte(`{"parsed":true,"details":{"genus":{}}}`), &res)
+	assert.NotNil(t, err)
+}
+
+func TestParseNameConcurrent(t *testing.T) {
+	cfg := gnparser.NewConfig(
+		gnparser.OptWithDetails(true),
+		gnparser.OptFormat("compact"),
+		gnparser.OptIsTest(true),
+	)
+	gnp := gnparser.New(cfg)
+	data := getTestData(t, "test_data.md")
+
+	var wg sync.WaitGroup
+	res := make([][]string, 8)
+	for i := range res {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res[i] = make([]string, len(data))
+			for j, v := range data {
+				res[i][j] = gnp.ParseName(v.name).Output(gnp.Format())
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range res {
+		for j, v := range data {
+			assert.Equal(t, res[i][j], v.jsonData, v.name)
+		}
+	}
+}
+
+func TestParseNamesCtx(t *testing.T) {
+	names := []string{"Pardosa moesta Banks, 1892", "Bubo bubo", "Aus bus"}
+	gnp := gnparser.New(gnparser.NewConfig())
+	res, err := gnp.ParseNamesCtx(context.Background(), names)
+	assert.Nil(t, err)
+	assert.Equal(t, len(res), 3)
+	assert.Equal(t, res[1].Idx, 1)
+	assert.Equal(t, res[1].Parsed.Canonical.Simple, "Bubo bubo")
+
+	gnp = gnp.ChangeConfig(gnparser.OptWithNoOrder(true), gnparser.OptJobsNum(3))
+	res, err = gnp.ParseNamesCtx(context.Background(), names)
+	assert.Nil(t, err)
+	assert.Equal(t, len(res), 3)
+	for _, v := range res {
+		assert.Equal(t, v.Parsed.Verbatim, names[v.Idx])
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res, err = gnp.ParseNamesCtx(ctx, names)
+	assert.Equal(t, err, context.Canceled)
+	assert.Nil(t, res)
+}
+
+func TestParseNameStreamCtx(t *testing.T) {
+	names := []string{
+		"Pardosa moesta Banks, 1892", "Bubo bubo", "Aus bus", "Bus cus",
+		"Homo sapiens", "Plantago major",
+	}
+	tests := []struct {
+		msg     string
+		noOrder bool
+	}{
+		{"ordered", false},
+		{"unordered", true},
+	}
+	for _, v := range tests {
+		cfg := gnparser.NewConfig(
+			gnparser.OptJobsNum(3),
+			gnparser.OptWithNoOrder(v.noOrder),
+		)
+		gnp := gnparser.New(cfg)
+		chIn := make(chan nameidx.NameIdx)
+		chOut := make(chan parsed.ParsedWithIdx)
+		go func() {
+			defer close(chIn)
+			for i := range names {
+				chIn <- nameidx.NameIdx{Index: i, NameString: names[i]}
+			}
+		}()
+
+		var err error
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err = gnp.ParseNameStreamCtx(context.Background(), chIn, chOut)
+		}()
+
+		var count int
+		for p := range chOut {
+			if !v.noOrder {
+				assert.Equal(t, p.Idx, count, v.msg)
+			}
+			assert.Equal(t, p.Parsed.Verbatim, names[p.Idx], v.msg)
+			count++
+		}
+		wg.Wait()
+		assert.Nil(t, err, v.msg)
+		assert.Equal(t, count, len(names), v.msg)
+	}
+}
+
+func TestParseNameStreamCtxCancel(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptJobsNum(4)))
+	ctx, cancel := context.WithCancel(context.Background())
+	chIn := make(chan nameidx.NameIdx)
+	chOut := make(chan parsed.ParsedWithIdx)
+	go func() {
+		defer close(chIn)
+		for i := 0; i < 1000; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case chIn <- nameidx.NameIdx{Index: i, NameString: "Bubo bubo"}:
+			}
+		}
+	}()
+
+	chErr := make(chan error)
+	go func() {
+		chErr <- gnp.ParseNameStreamCtx(ctx, chIn, chOut)
+	}()
+
+	<-chOut
+	cancel()
+	for range chOut {
+	}
+	assert.Equal(t, <-chErr, context.Canceled)
+}
+
+func TestCache(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptCacheSize(2)))
+	assert.Equal(t, gnp.CacheStats(), gnparser.CacheStats{Capacity: 2})
+
+	p1 := gnp.ParseName("Bubo bubo")
+	p2 := gnp.ParseName("Bubo bubo")
+	assert.Equal(t, p1, p2)
+	assert.Equal(t, gnp.CacheStats(),
+		gnparser.CacheStats{Hits: 1, Misses: 1, Size: 1, Capacity: 2})
+
+	gnp.ParseName("Aus bus")
+	gnp.ParseName("Bus cus")
+	stats := gnp.CacheStats()
+	assert.Equal(t, stats.Size, 2)
+	// "Bubo bubo" got evicted as the least recently used.
+	gnp.ParseName("Bubo bubo")
+	assert.Equal(t, gnp.CacheStats().Misses, uint64(4))
+
+	// settings that change output are part of the key
+	gnpDet := gnp.ChangeConfig(gnparser.OptWithDetails(true))
+	p3 := gnpDet.ParseName("Bubo bubo")
+	assert.NotNil(t, p3.Details)
+	assert.Equal(t, gnpDet.CacheStats().Misses, uint64(5))
+
+	gnpNoCache := gnp.ChangeConfig(gnparser.OptCacheSize(0))
+	gnpNoCache.ParseName("Bubo bubo")
+	assert.Equal(t, gnpNoCache.CacheStats(), gnparser.CacheStats{})
+}
+
+func TestParseNamesDedup(t *testing.T) {
+	names := []string{"Bubo bubo", "Aus bus", "Bubo bubo", "Bubo bubo", "Aus bus"}
+	tests := []struct {
+		msg     string
+		noOrder bool
+	}{
+		{"ordered", false},
+		{"unordered", true},
+	}
+	for _, v := range tests {
+		cfg := gnparser.NewConfig(
+			gnparser.OptCacheSize(10),
+			gnparser.OptWithNoOrder(v.noOrder),
+		)
+		gnp := gnparser.New(cfg)
+		res := gnp.ParseNames(names)
+		assert.Equal(t, len(res), len(names), v.msg)
+		counts := make(map[string]int)
+		for i := range res {
+			if !v.noOrder {
+				assert.Equal(t, res[i].Verbatim, names[i], v.msg)
+			}
+			counts[res[i].Verbatim]++
+		}
+		assert.Equal(t, counts, map[string]int{"Bubo bubo": 3, "Aus bus": 2}, v.msg)
+		assert.Equal(t, gnp.CacheStats().Misses, uint64(2), v.msg)
+		assert.Equal(t, gnp.CacheStats().Hits, uint64(0), v.msg)
+	}
+}
+
 func TestParseLowCaseName(t *testing.T) {
 	tests := []struct {
 		msg, in, out string
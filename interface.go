@@ -22,19 +22,46 @@ type GNparser interface {
 	// parsed results in the same order as the input.
 	ParseNames([]string) []parsed.Parsed
 
+	// ParseNamesCtx takes a context and a slice of name-strings, and returns
+	// a slice of parsed results with positions of their name-strings in the
+	// input. Results follow the order of the input, unless WithNoOrder
+	// option is true. It returns an error if the context is canceled before
+	// parsing is done.
+	ParseNamesCtx(context.Context, []string) ([]parsed.ParsedWithIdx, error)
+
 	// ParseNameStream takes a context, an input channel that takes a
 	// a name-string and its position in the input. It returns parsed results
 	// that come in the same order as the input.
 	ParseNameStream(context.Context, <-chan nameidx.NameIdx, chan<- parsed.Parsed)
 
+	// ParseNameStreamCtx takes a context, an input channel of name-strings
+	// with their positions in the input, and an output channel. Parsed
+	// results keep the position of their name-string, so they can be
+	// reconciled with the input even if WithNoOrder option is true. It
+	// returns an error if the context is canceled, or if results cannot be
+	// assembled.
+	ParseNameStreamCtx(
+		context.Context,
+		<-chan nameidx.NameIdx,
+		chan<- parsed.ParsedWithIdx,
+	) error
+
 	// Format returns currently chosen desired output format of a JSON or
 	// CSV output.
 	Format() gnfmt.Format
 
+	// Fields returns columns of CSV and TSV outputs. If they are empty,
+	// parsed.DefaultFields are used.
+	Fields() []parsed.Field
+
 	// ChangeConfig allows to modify settings of GNparser. Changing settings
 	// might modify parsing process, and the final output of results.
 	ChangeConfig(opts ...Option) GNparser
 
+	// CacheStats returns hits, misses and size of the cache of parsed
+	// results. The cache is enabled by a positive CacheSize setting.
+	CacheStats() CacheStats
+
 	// Debug parses a string and outputs raw AST tree from PEG engine.
 	Debug(s string) []byte
 }
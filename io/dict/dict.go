@@ -6,6 +6,11 @@ import (
 	"embed"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/gnames/gnparser/ent/str"
 )
 
 //go:embed data
@@ -24,6 +29,10 @@ type Dictionary struct {
 	// This list is used to detect ICN name-strings so we can parse a word in
 	// parenthesis after genus word as an author instead of subgenus.
 	AuthorICN map[string]struct{}
+	// Authors contains standard abbreviations of botanical authors (IPNI,
+	// Brummitt & Powell). Keys are created by AuthorKey function from the
+	// standard forms and their variants, values are the standard forms.
+	Authors map[string]string
 }
 
 // LoadDictionary creates dictionary from text files.
@@ -31,6 +40,7 @@ func LoadDictionary() *Dictionary {
 	d := Dictionary{
 		Bacteria:  readBacterialData(),
 		AuthorICN: readAuthorICNData(),
+		Authors:   readAuthorsData(),
 	}
 	return &d
 }
@@ -48,6 +58,36 @@ func readAuthorICNData() map[string]struct{} {
 	return m
 }
 
+func readAuthorsData() map[string]string {
+	m := make(map[string]string)
+	scanAuthorsFile("authors.txt", m)
+	return m
+}
+
+var filiusRe = regexp.MustCompile(`\bfil(\.|ius\b)`)
+
+// AuthorKey converts a name of an author to a key for the Authors
+// dictionary. The key ignores case, diacritics, spaces and punctuation,
+// so "L.", "l" and "L" have the same key. Filius is normalized to "f",
+// making "Hook.f." and "Hook. fil." the same.
+func AuthorKey(s string) string {
+	s = str.Normalize(filiusRe.ReplaceAllString(s, "f."))
+	var res strings.Builder
+	for _, v := range s {
+		if unicode.IsLetter(v) || unicode.IsDigit(v) {
+			res.WriteRune(unicode.ToLower(v))
+		}
+	}
+	return res.String()
+}
+
+// StandardAuthor returns the standard form of an author's name. If
+// the author is not in the dictionary, it returns false.
+func (d *Dictionary) StandardAuthor(s string) (string, bool) {
+	res, ok := d.Authors[AuthorKey(s)]
+	return res, ok
+}
+
 func scanAuthorICNFIle(path string, m map[string]struct{}) {
 	path = fmt.Sprintf("data/%s", path)
 	f, err := data.Open(path)
@@ -71,3 +111,25 @@ func scanBacterialFile(path string, isHomonym bool, m map[string]bool) {
 		m[sc.Text()] = isHomonym
 	}
 }
+
+// scanAuthorsFile reads lines that contain a standard form of an author,
+// optionally followed by a tab and variants separated by '|'.
+func scanAuthorsFile(path string, m map[string]string) {
+	path = fmt.Sprintf("data/%s", path)
+	f, err := data.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Split(sc.Text(), "\t")
+		std := fields[0]
+		m[AuthorKey(std)] = std
+		if len(fields) < 2 {
+			continue
+		}
+		for _, v := range strings.Split(fields[1], "|") {
+			m[AuthorKey(v)] = std
+		}
+	}
+}
@@ -35,4 +35,55 @@ func TestLoadDictionary(t *testing.T) {
 		_, ok := d.AuthorICN["Abramov"]
 		assert.True(t, ok)
 	})
+	t.Run("loads authors dictionary", func(t *testing.T) {
+		assert.Greater(t, len(d.Authors), 100)
+	})
+}
+
+func TestStandardAuthor(t *testing.T) {
+	d := dict.LoadDictionary()
+	tests := []struct {
+		msg, author, std string
+		ok               bool
+	}{
+		{"standard", "L.", "L.", true},
+		{"abbr", "Linn.", "L.", true},
+		{"full", "Linnaeus", "L.", true},
+		{"diacritics", "Linné", "L.", true},
+		{"spaces", "R. Br.", "R.Br.", true},
+		{"filius", "Hook. fil.", "Hook.f.", true},
+		{"filius f.", "Hook.f.", "Hook.f.", true},
+		{"filius full", "Hooker f.", "Hook.f.", true},
+		{"umlaut", "Muell. Arg.", "Müll.Arg.", true},
+		{"unknown", "Smith", "", false},
+		{"ambiguous", "Saint-Hilaire", "", false},
+		{"ambiguous abbr", "St.-Hil.", "", false},
+		{"ambiguous Candolle", "Candolle", "", false},
+		{"ambiguous de Candolle", "de Candolle", "", false},
+		{"A.P. de Candolle", "A.P.de Candolle", "DC.", true},
+	}
+
+	for _, v := range tests {
+		res, ok := d.StandardAuthor(v.author)
+		assert.Equal(t, ok, v.ok, v.msg)
+		assert.Equal(t, res, v.std, v.msg)
+	}
+}
+
+func TestAuthorKey(t *testing.T) {
+	tests := []struct {
+		msg, author, key string
+	}{
+		{"abbr", "L.", "l"},
+		{"spaces", "R. Br.", "rbr"},
+		{"filius", "Rchb. fil.", "rchbf"},
+		{"filius full", "Rchb. filius", "rchbf"},
+		{"not filius", "Filipjev", "filipjev"},
+		{"diacritics", "Forsskål", "forsskaol"},
+		{"hyphen", "Buch.-Ham.", "buchham"},
+	}
+
+	for _, v := range tests {
+		assert.Equal(t, dict.AuthorKey(v.author), v.key, v.msg)
+	}
 }
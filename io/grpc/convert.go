@@ -0,0 +1,311 @@
+package grpc
+
+import (
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/grpc/protob"
+)
+
+// toProto converts parsed.Parsed to its Protobuf message.
+func toProto(p parsed.Parsed) *protob.Parsed {
+	res := protob.Parsed{
+		Parsed:         p.Parsed,
+		Quality:        int32(p.ParseQuality),
+		Verbatim:       p.Verbatim,
+		Normalized:     p.Normalized,
+		Cardinality:    int32(p.Cardinality),
+		RankNormalized: p.Rank.String(),
+		Authorship:     authorshipToProto(p.Authorship),
+		Code:           p.Code.String(),
+		Virus:          p.Virus,
+		VirusCategory:  p.VirusCategory.String(),
+		DaggerChar:     p.DaggerChar,
+		Hybrid:         annotToStr(p.Hybrid),
+		GraftChimera:   annotToStr(p.GraftChimera),
+		Surrogate:      annotToStr(p.Surrogate),
+		Tail:           p.Tail,
+		Details:        detailsToProto(p.Details),
+		Id:             p.VerbatimID,
+		ParserVersion:  p.ParserVersion,
+	}
+
+	for _, v := range p.QualityWarnings {
+		qw := protob.QualityWarning{
+			Quality: int32(v.Quality),
+			Warning: v.Warning.String(),
+		}
+		res.QualityWarnings = append(res.QualityWarnings, &qw)
+	}
+
+	if p.Canonical != nil {
+		res.Canonical = &protob.Canonical{
+			Stemmed: p.Canonical.Stemmed,
+			Simple:  p.Canonical.Simple,
+			Full:    p.Canonical.Full,
+		}
+	}
+
+	for _, v := range p.Parents {
+		pr := protob.Parent{
+			Rank:       v.Rank.String(),
+			Normalized: v.Normalized,
+			Authorship: authorshipToProto(v.Authorship),
+		}
+		if v.Canonical != nil {
+			pr.Canonical = &protob.Canonical{
+				Stemmed: v.Canonical.Stemmed,
+				Simple:  v.Canonical.Simple,
+				Full:    v.Canonical.Full,
+			}
+		}
+		res.Parents = append(res.Parents, &pr)
+	}
+
+	if p.InferredCode != nil {
+		res.InferredCode = &protob.CodeInference{
+			Code: p.InferredCode.Code.String(),
+		}
+		for _, v := range p.InferredCode.Evidence {
+			res.InferredCode.Evidence = append(res.InferredCode.Evidence, v.String())
+		}
+	}
+
+	if p.Bacteria != nil {
+		res.Bacteria = p.Bacteria.String()
+	}
+
+	if p.Strain != nil {
+		res.Strain = &protob.Strain{
+			Verbatim:    p.Strain.Verbatim,
+			Designation: p.Strain.Designation,
+			Serovar:     p.Strain.Serovar,
+			Pathovar:    p.Strain.Pathovar,
+			Biovar:      p.Strain.Biovar,
+			Accessions:  p.Strain.Accessions,
+		}
+	}
+
+	if p.TaxonConcept != nil {
+		res.TaxonConcept = &protob.TaxonConcept{
+			Verbatim:  p.TaxonConcept.Verbatim,
+			Type:      p.TaxonConcept.Type.String(),
+			According: p.TaxonConcept.According,
+			Non:       p.TaxonConcept.Non,
+			ProParte:  p.TaxonConcept.ProParte,
+		}
+	}
+
+	for _, v := range p.NomenclaturalStatus {
+		ns := protob.NomenclaturalStatus{
+			Verbatim: v.Verbatim,
+			Status:   v.Status.String(),
+		}
+		res.NomenclaturalStatus = append(res.NomenclaturalStatus, &ns)
+	}
+
+	for _, v := range p.Words {
+		w := protob.Word{
+			Verbatim:   v.Verbatim,
+			Normalized: v.Normalized,
+			Type:       v.Type.String(),
+			Start:      int32(v.Start),
+			End:        int32(v.End),
+		}
+		res.Words = append(res.Words, &w)
+	}
+	return &res
+}
+
+func annotToStr(a *parsed.Annotation) string {
+	if a == nil {
+		return ""
+	}
+	return a.String()
+}
+
+func authorshipToProto(au *parsed.Authorship) *protob.Authorship {
+	if au == nil {
+		return nil
+	}
+	return &protob.Authorship{
+		Verbatim:      au.Verbatim,
+		Normalized:    au.Normalized,
+		Year:          au.Year,
+		Authors:       au.Authors,
+		AuthorDetails: authorsToProto(au.AuthorDetails),
+		Original:      authGroupToProto(au.Original),
+		Combination:   authGroupToProto(au.Combination),
+	}
+}
+
+func authGroupToProto(ag *parsed.AuthGroup) *protob.AuthGroup {
+	if ag == nil {
+		return nil
+	}
+	return &protob.AuthGroup{
+		Authors:       ag.Authors,
+		AuthorDetails: authorsToProto(ag.AuthorDetails),
+		Year:          yearToProto(ag.Year),
+		ExAuthors:     authTeamToProto(ag.ExAuthors),
+		EmendAuthors:  authTeamToProto(ag.EmendAuthors),
+	}
+}
+
+func authTeamToProto(au *parsed.Authors) *protob.Authors {
+	if au == nil {
+		return nil
+	}
+	return &protob.Authors{
+		Authors:       au.Authors,
+		AuthorDetails: authorsToProto(au.AuthorDetails),
+		Year:          yearToProto(au.Year),
+	}
+}
+
+func authorsToProto(as []parsed.Author) []*protob.Author {
+	if len(as) == 0 {
+		return nil
+	}
+	res := make([]*protob.Author, len(as))
+	for i, v := range as {
+		res[i] = &protob.Author{
+			Value:     v.Value,
+			Initials:  v.Initials,
+			Particles: v.Particles,
+			Surname:   v.Surname,
+			Filius:    v.Filius,
+			Suffix:    v.Suffix,
+			Standard:  v.Standard,
+			Key:       v.Key,
+		}
+	}
+	return res
+}
+
+func yearToProto(yr *parsed.Year) *protob.Year {
+	if yr == nil {
+		return nil
+	}
+	return &protob.Year{
+		Value:         yr.Value,
+		IsApproximate: yr.IsApproximate,
+		Verbatim:      yr.Verbatim,
+		Start:         int32(yr.Start),
+		End:           int32(yr.End),
+	}
+}
+
+// detailsToProto converts Details to their Protobuf message. Elements of
+// hybrid and graft-chimera formulas are converted recursively.
+func detailsToProto(d parsed.Details) *protob.Details {
+	switch dt := d.(type) {
+	case parsed.DetailsUninomial:
+		u := dt.Uninomial
+		return &protob.Details{
+			Details: &protob.Details_Uninomial{
+				Uninomial: &protob.Uninomial{
+					Value:          u.Value,
+					Rank:           u.Rank,
+					RankNormalized: u.RankNormalized.String(),
+					RankCode:       u.RankCode.String(),
+					Cultivar:       u.Cultivar,
+					Parent:         u.Parent,
+					Authorship:     authorshipToProto(u.Authorship),
+				},
+			},
+		}
+	case parsed.DetailsSpecies:
+		return &protob.Details{
+			Details: &protob.Details_Species{
+				Species: speciesToProto(dt.Species),
+			},
+		}
+	case parsed.DetailsInfraspecies:
+		isp := protob.Infraspecies{
+			Species: speciesToProto(dt.Infraspecies.Species),
+		}
+		for _, v := range dt.Infraspecies.Infraspecies {
+			el := protob.InfraspeciesElem{
+				Value:          v.Value,
+				Rank:           v.Rank,
+				RankNormalized: v.RankNormalized.String(),
+				Authorship:     authorshipToProto(v.Authorship),
+			}
+			isp.Infraspecies = append(isp.Infraspecies, &el)
+		}
+		return &protob.Details{
+			Details: &protob.Details_Infraspecies{Infraspecies: &isp},
+		}
+	case parsed.DetailsComparison:
+		c := dt.Comparison
+		return &protob.Details{
+			Details: &protob.Details_Comparison{
+				Comparison: &protob.Comparison{
+					Genus:             c.Genus,
+					Species:           c.Species,
+					Cultivar:          c.Cultivar,
+					SpeciesAuthorship: authorshipToProto(c.SpeciesAuthorship),
+					ComparisonMarker:  c.CompMarker,
+				},
+			},
+		}
+	case parsed.DetailsApproximation:
+		a := dt.Approximation
+		return &protob.Details{
+			Details: &protob.Details_Approximation{
+				Approximation: &protob.Approximation{
+					Genus:               a.Genus,
+					Species:             a.Species,
+					Cultivar:            a.Cultivar,
+					SpeciesAuthorship:   authorshipToProto(a.SpeciesAuthorship),
+					ApproximationMarker: a.ApproxMarker,
+					Ignored:             a.Ignored,
+				},
+			},
+		}
+	case parsed.DetailsVirus:
+		v := dt.Virus
+		return &protob.Details{
+			Details: &protob.Details_Virus{
+				Virus: &protob.Virus{
+					Genus:   v.Genus,
+					Species: v.Species,
+					Name:    v.Name,
+					Strain:  v.Strain,
+					Acronym: v.Acronym,
+				},
+			},
+		}
+	case parsed.DetailsHybridFormula:
+		return &protob.Details{
+			Details: &protob.Details_HybridFormula{
+				HybridFormula: formulaToProto(dt.HybridFormula),
+			},
+		}
+	case parsed.DetailsGraftChimeraFormula:
+		return &protob.Details{
+			Details: &protob.Details_GraftChimeraFormula{
+				GraftChimeraFormula: formulaToProto(dt.GraftChimeraFormula),
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+func speciesToProto(sp parsed.Species) *protob.Species {
+	return &protob.Species{
+		Genus:      sp.Genus,
+		Subgenus:   sp.Subgenus,
+		Species:    sp.Species,
+		Cultivar:   sp.Cultivar,
+		Authorship: authorshipToProto(sp.Authorship),
+	}
+}
+
+func formulaToProto(ds []parsed.Details) *protob.Formula {
+	res := protob.Formula{Elements: make([]*protob.Details, len(ds))}
+	for i := range ds {
+		res.Elements[i] = detailsToProto(ds[i])
+	}
+	return &res
+}
@@ -0,0 +1,170 @@
+// Package grpc provides gRPC service for parsing scientific names. Messages
+// of the service are described in protob/gnparser.proto.
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/nameidx"
+	"github.com/gnames/gnparser/ent/nomcode"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/grpc/protob"
+	grpclib "google.golang.org/grpc"
+)
+
+type gnparserServer struct {
+	protob.UnimplementedGNparserServer
+	gnp gnparser.GNparser
+}
+
+// NewServer creates a gRPC server with registered GNparser service.
+// Every request can modify settings of the given GNparser with Options.
+func NewServer(gnp gnparser.GNparser) *grpclib.Server {
+	srv := grpclib.NewServer()
+	protob.RegisterGNparserServer(srv, &gnparserServer{gnp: gnp})
+	return srv
+}
+
+// Run starts GNparser gRPC service on the given port.
+func Run(gnp gnparser.GNparser, port int) error {
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return err
+	}
+	return NewServer(gnp).Serve(l)
+}
+
+// Ping returns "pong".
+func (s *gnparserServer) Ping(
+	_ context.Context,
+	_ *protob.Void,
+) (*protob.Pong, error) {
+	return &protob.Pong{Value: "pong"}, nil
+}
+
+// Ver returns the version and the build timestamp of GNparser.
+func (s *gnparserServer) Ver(
+	_ context.Context,
+	_ *protob.Void,
+) (*protob.Version, error) {
+	ver := s.gnp.GetVersion()
+	return &protob.Version{Version: ver.Version, Build: ver.Build}, nil
+}
+
+// ParseName parses one name-string.
+func (s *gnparserServer) ParseName(
+	_ context.Context,
+	input *protob.NameInput,
+) (*protob.Parsed, error) {
+	gnp := s.gnp.ChangeConfig(opts(input.GetOptions())...)
+	res := gnp.ParseName(input.GetName())
+	return toProto(res), nil
+}
+
+// ParseNames parses a batch of name-strings.
+func (s *gnparserServer) ParseNames(
+	ctx context.Context,
+	input *protob.NamesInput,
+) (*protob.ParsedNames, error) {
+	gnp := s.gnp.ChangeConfig(opts(input.GetOptions())...)
+	ps, err := gnp.ParseNamesCtx(ctx, input.GetNames())
+	if err != nil {
+		return nil, err
+	}
+	res := protob.ParsedNames{Results: make([]*protob.Parsed, len(ps))}
+	for i := range ps {
+		res.Results[i] = toProto(ps[i].Parsed)
+	}
+	return &res, nil
+}
+
+// Parse parses a stream of name-strings using GNparser.ParseNameStream.
+// Options of the first message are used for the whole stream.
+func (s *gnparserServer) Parse(stream protob.GNparser_ParseServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	gnp := s.gnp.ChangeConfig(
+		append(opts(first.GetOptions()), gnparser.OptWithNoOrder(false))...,
+	)
+	chIn := make(chan nameidx.NameIdx)
+	chOut := make(chan parsed.Parsed)
+	go gnp.ParseNameStream(ctx, chIn, chOut)
+
+	chErr := make(chan error, 1)
+	go func() {
+		defer close(chIn)
+		input := first
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case chIn <- nameidx.NameIdx{Index: i, NameString: input.GetName()}:
+			}
+			var err error
+			input, err = stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				chErr <- err
+				cancel()
+				return
+			}
+		}
+	}()
+
+	for p := range chOut {
+		if err := stream.Send(toProto(p)); err != nil {
+			cancel()
+			return err
+		}
+	}
+
+	select {
+	case err := <-chErr:
+		return err
+	default:
+		return ctx.Err()
+	}
+}
+
+// opts converts protob.Options to GNparser options. Only options that
+// are set by a client are converted, so other settings of the server
+// stay the same.
+func opts(o *protob.Options) []gnparser.Option {
+	var res []gnparser.Option
+	if o == nil {
+		return res
+	}
+	if o.WithDetails != nil {
+		res = append(res, gnparser.OptWithDetails(*o.WithDetails))
+	}
+	if o.WithCultivars != nil {
+		res = append(res, gnparser.OptWithCultivars(*o.WithCultivars))
+	}
+	if o.PreserveDiaereses != nil {
+		res = append(res, gnparser.OptWithPreserveDiaereses(*o.PreserveDiaereses))
+	}
+	if o.Capitalize != nil {
+		res = append(res, gnparser.OptWithCapitaliation(*o.Capitalize))
+	}
+	if o.IgnoreHtmlTags != nil {
+		res = append(res, gnparser.OptIgnoreHTMLTags(*o.IgnoreHtmlTags))
+	}
+	if o.GetCode() != "" {
+		res = append(res, gnparser.OptCode(nomcode.New(o.GetCode())))
+	}
+	return res
+}
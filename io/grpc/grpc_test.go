@@ -0,0 +1,169 @@
+package grpc_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	gnpgrpc "github.com/gnames/gnparser/io/grpc"
+	"github.com/gnames/gnparser/io/grpc/protob"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+func newClient(t *testing.T, opts ...gnparser.Option) protob.GNparserClient {
+	l := bufconn.Listen(1024 * 1024)
+	srv := gnpgrpc.NewServer(gnparser.New(gnparser.NewConfig(opts...)))
+	go func() {
+		_ = srv.Serve(l)
+	}()
+	t.Cleanup(srv.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return l.Dial()
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	assert.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return protob.NewGNparserClient(conn)
+}
+
+func TestPing(t *testing.T) {
+	cl := newClient(t)
+	res, err := cl.Ping(context.Background(), &protob.Void{})
+	assert.Nil(t, err)
+	assert.Equal(t, res.Value, "pong")
+
+	ver, err := cl.Ver(context.Background(), &protob.Void{})
+	assert.Nil(t, err)
+	assert.Equal(t, ver.Version, gnparser.Version)
+}
+
+func TestParseName(t *testing.T) {
+	cl := newClient(t)
+	ctx := context.Background()
+	input := protob.NameInput{Name: "Pardosa moesta Banks, 1892"}
+	res, err := cl.ParseName(ctx, &input)
+	assert.Nil(t, err)
+	assert.True(t, res.Parsed)
+	assert.Equal(t, res.Canonical.Simple, "Pardosa moesta")
+	assert.Equal(t, res.Authorship.Year, "1892")
+	assert.Equal(t, res.Quality, int32(1))
+	assert.Equal(t, res.RankNormalized, "SPECIES")
+	assert.Nil(t, res.Details)
+	assert.Nil(t, res.Words)
+
+	input.Options = &protob.Options{WithDetails: proto.Bool(true)}
+	res, err = cl.ParseName(ctx, &input)
+	assert.Nil(t, err)
+	sp := res.Details.GetSpecies()
+	assert.NotNil(t, sp)
+	assert.Equal(t, sp.Genus, "Pardosa")
+	assert.Equal(t, sp.Authorship.Original.Year.Value, "1892")
+	assert.Equal(t, len(res.Words), 4)
+	assert.Equal(t, res.Words[1].Type, "SPECIES")
+	assert.Equal(t, len(res.Parents), 1)
+	assert.Equal(t, res.Parents[0].Rank, "GENUS")
+	assert.Equal(t, res.Parents[0].Canonical.Simple, "Pardosa")
+
+	input = protob.NameInput{
+		Name:    "Aus bus var. cus × Dus eus",
+		Options: &protob.Options{WithDetails: proto.Bool(true)},
+	}
+	res, err = cl.ParseName(ctx, &input)
+	assert.Nil(t, err)
+	assert.Equal(t, res.Hybrid, "HYBRID_FORMULA")
+	hf := res.Details.GetHybridFormula()
+	assert.Equal(t, len(hf.Elements), 2)
+	isp := hf.Elements[0].GetInfraspecies()
+	assert.Equal(t, isp.Infraspecies[0].Rank, "var.")
+	assert.Equal(t, isp.Infraspecies[0].RankNormalized, "VARIETY")
+	assert.Equal(t, hf.Elements[1].GetSpecies().Species, "eus")
+
+	input = protob.NameInput{
+		Name: "Aus bus + Cus dus",
+		Options: &protob.Options{
+			WithDetails:   proto.Bool(true),
+			WithCultivars: proto.Bool(true),
+		},
+	}
+	res, err = cl.ParseName(ctx, &input)
+	assert.Nil(t, err)
+	gf := res.Details.GetGraftChimeraFormula()
+	assert.Equal(t, len(gf.Elements), 2)
+	assert.Equal(t, res.InferredCode.Code, "ICNCP")
+}
+
+func TestServerOptions(t *testing.T) {
+	cl := newClient(t, gnparser.OptWithDetails(true))
+	ctx := context.Background()
+	input := protob.NameInput{
+		Name:    "Pardosa moesta Banks, 1892",
+		Options: &protob.Options{Code: "zoo"},
+	}
+	res, err := cl.ParseName(ctx, &input)
+	assert.Nil(t, err)
+	assert.NotNil(t, res.Details)
+	assert.Equal(t, "ICZN", res.Code)
+
+	input.Options = &protob.Options{WithDetails: proto.Bool(false)}
+	res, err = cl.ParseName(ctx, &input)
+	assert.Nil(t, err)
+	assert.Nil(t, res.Details)
+	assert.Equal(t, "", res.Code)
+}
+
+func TestParseNames(t *testing.T) {
+	cl := newClient(t)
+	names := []string{"Bubo bubo", "Not a name", "Homo sapiens L."}
+	input := protob.NamesInput{Names: names}
+	res, err := cl.ParseNames(context.Background(), &input)
+	assert.Nil(t, err)
+	assert.Equal(t, len(res.Results), 3)
+	for i := range names {
+		assert.Equal(t, res.Results[i].Verbatim, names[i])
+	}
+	assert.False(t, res.Results[1].Parsed)
+	assert.Equal(t, res.Results[2].Authorship.Normalized, "L.")
+}
+
+func TestParse(t *testing.T) {
+	cl := newClient(t)
+	names := []string{
+		"Pardosa moesta Banks, 1892", "Bubo bubo", "Aus bus", "Bus cus",
+		"Homo sapiens", "Plantago major",
+	}
+	stream, err := cl.Parse(context.Background())
+	assert.Nil(t, err)
+
+	go func() {
+		for i, v := range names {
+			input := protob.NameInput{Name: v}
+			if i == 0 {
+				input.Options = &protob.Options{WithDetails: proto.Bool(true)}
+			}
+			_ = stream.Send(&input)
+		}
+		_ = stream.CloseSend()
+	}()
+
+	var res []*protob.Parsed
+	for {
+		p, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		res = append(res, p)
+	}
+	assert.Equal(t, len(res), len(names))
+	for i := range names {
+		assert.Equal(t, res[i].Verbatim, names[i])
+		assert.NotNil(t, res[i].Details)
+	}
+}
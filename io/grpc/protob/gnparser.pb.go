@@ -0,0 +1,3266 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: io/grpc/protob/gnparser.proto
+
+// Package protob describes gRPC service of GNparser. Messages mirror
+// parsed.Parsed output. Values of enumerated fields (word types, warnings,
+// annotations, codes etc.) are the same strings that are used in JSON
+// output.
+//
+// To regenerate Go code run from the root of the project:
+//
+// protoc --go_out=. --go_opt=paths=source_relative \
+//   --go-grpc_out=. --go-grpc_opt=paths=source_relative \
+//   io/grpc/protob/gnparser.proto
+
+package protob
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Void struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Void) Reset() {
+	*x = Void{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Void) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Void) ProtoMessage() {}
+
+func (x *Void) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Void.ProtoReflect.Descriptor instead.
+func (*Void) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{0}
+}
+
+type Pong struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Pong) Reset() {
+	*x = Pong{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pong) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pong) ProtoMessage() {}
+
+func (x *Pong) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pong.ProtoReflect.Descriptor instead.
+func (*Pong) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Pong) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Version struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Build   string `protobuf:"bytes,2,opt,name=build,proto3" json:"build,omitempty"`
+}
+
+func (x *Version) Reset() {
+	*x = Version{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Version) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Version) ProtoMessage() {}
+
+func (x *Version) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Version.ProtoReflect.Descriptor instead.
+func (*Version) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Version) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Version) GetBuild() string {
+	if x != nil {
+		return x.Build
+	}
+	return ""
+}
+
+// Options modify parsing of names. Only options that are set by a client
+// are changed, others keep settings of the server.
+type Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WithDetails       *bool `protobuf:"varint,1,opt,name=with_details,json=withDetails,proto3,oneof" json:"with_details,omitempty"`
+	WithCultivars     *bool `protobuf:"varint,2,opt,name=with_cultivars,json=withCultivars,proto3,oneof" json:"with_cultivars,omitempty"`
+	PreserveDiaereses *bool `protobuf:"varint,3,opt,name=preserve_diaereses,json=preserveDiaereses,proto3,oneof" json:"preserve_diaereses,omitempty"`
+	Capitalize        *bool `protobuf:"varint,4,opt,name=capitalize,proto3,oneof" json:"capitalize,omitempty"`
+	IgnoreHtmlTags    *bool `protobuf:"varint,5,opt,name=ignore_html_tags,json=ignoreHtmlTags,proto3,oneof" json:"ignore_html_tags,omitempty"`
+	// code is a nomenclatural code, for example "zoo", "bot", "ICN".
+	// An empty code keeps the code of the server.
+	Code string `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *Options) Reset() {
+	*x = Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Options) ProtoMessage() {}
+
+func (x *Options) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Options.ProtoReflect.Descriptor instead.
+func (*Options) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Options) GetWithDetails() bool {
+	if x != nil && x.WithDetails != nil {
+		return *x.WithDetails
+	}
+	return false
+}
+
+func (x *Options) GetWithCultivars() bool {
+	if x != nil && x.WithCultivars != nil {
+		return *x.WithCultivars
+	}
+	return false
+}
+
+func (x *Options) GetPreserveDiaereses() bool {
+	if x != nil && x.PreserveDiaereses != nil {
+		return *x.PreserveDiaereses
+	}
+	return false
+}
+
+func (x *Options) GetCapitalize() bool {
+	if x != nil && x.Capitalize != nil {
+		return *x.Capitalize
+	}
+	return false
+}
+
+func (x *Options) GetIgnoreHtmlTags() bool {
+	if x != nil && x.IgnoreHtmlTags != nil {
+		return *x.IgnoreHtmlTags
+	}
+	return false
+}
+
+func (x *Options) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type NameInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Options *Options `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *NameInput) Reset() {
+	*x = NameInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NameInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameInput) ProtoMessage() {}
+
+func (x *NameInput) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NameInput.ProtoReflect.Descriptor instead.
+func (*NameInput) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NameInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NameInput) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type NamesInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names   []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Options *Options `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *NamesInput) Reset() {
+	*x = NamesInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamesInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamesInput) ProtoMessage() {}
+
+func (x *NamesInput) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamesInput.ProtoReflect.Descriptor instead.
+func (*NamesInput) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NamesInput) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *NamesInput) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ParsedNames struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*Parsed `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ParsedNames) Reset() {
+	*x = ParsedNames{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParsedNames) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsedNames) ProtoMessage() {}
+
+func (x *ParsedNames) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsedNames.ProtoReflect.Descriptor instead.
+func (*ParsedNames) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ParsedNames) GetResults() []*Parsed {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Parsed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parsed          bool              `protobuf:"varint,1,opt,name=parsed,proto3" json:"parsed,omitempty"`
+	Quality         int32             `protobuf:"varint,2,opt,name=quality,proto3" json:"quality,omitempty"`
+	QualityWarnings []*QualityWarning `protobuf:"bytes,3,rep,name=quality_warnings,json=qualityWarnings,proto3" json:"quality_warnings,omitempty"`
+	Verbatim        string            `protobuf:"bytes,4,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	Normalized      string            `protobuf:"bytes,5,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	Canonical       *Canonical        `protobuf:"bytes,6,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	Cardinality     int32             `protobuf:"varint,7,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+	Authorship      *Authorship       `protobuf:"bytes,8,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	Code            string            `protobuf:"bytes,9,opt,name=code,proto3" json:"code,omitempty"`
+	InferredCode    *CodeInference    `protobuf:"bytes,10,opt,name=inferred_code,json=inferredCode,proto3" json:"inferred_code,omitempty"`
+	// bacteria is "yes", "maybe" or empty.
+	Bacteria            string                 `protobuf:"bytes,11,opt,name=bacteria,proto3" json:"bacteria,omitempty"`
+	Strain              *Strain                `protobuf:"bytes,12,opt,name=strain,proto3" json:"strain,omitempty"`
+	TaxonConcept        *TaxonConcept          `protobuf:"bytes,13,opt,name=taxon_concept,json=taxonConcept,proto3" json:"taxon_concept,omitempty"`
+	NomenclaturalStatus []*NomenclaturalStatus `protobuf:"bytes,14,rep,name=nomenclatural_status,json=nomenclaturalStatus,proto3" json:"nomenclatural_status,omitempty"`
+	Virus               bool                   `protobuf:"varint,15,opt,name=virus,proto3" json:"virus,omitempty"`
+	VirusCategory       string                 `protobuf:"bytes,16,opt,name=virus_category,json=virusCategory,proto3" json:"virus_category,omitempty"`
+	DaggerChar          bool                   `protobuf:"varint,17,opt,name=dagger_char,json=daggerChar,proto3" json:"dagger_char,omitempty"`
+	Hybrid              string                 `protobuf:"bytes,18,opt,name=hybrid,proto3" json:"hybrid,omitempty"`
+	GraftChimera        string                 `protobuf:"bytes,19,opt,name=graft_chimera,json=graftChimera,proto3" json:"graft_chimera,omitempty"`
+	Surrogate           string                 `protobuf:"bytes,20,opt,name=surrogate,proto3" json:"surrogate,omitempty"`
+	Tail                string                 `protobuf:"bytes,21,opt,name=tail,proto3" json:"tail,omitempty"`
+	Details             *Details               `protobuf:"bytes,22,opt,name=details,proto3" json:"details,omitempty"`
+	Words               []*Word                `protobuf:"bytes,23,rep,name=words,proto3" json:"words,omitempty"`
+	Id                  string                 `protobuf:"bytes,24,opt,name=id,proto3" json:"id,omitempty"`
+	ParserVersion       string                 `protobuf:"bytes,25,opt,name=parser_version,json=parserVersion,proto3" json:"parser_version,omitempty"`
+	// rank_normalized is a normalized rank of a name, for example
+	// "SUBSPECIES".
+	RankNormalized string `protobuf:"bytes,26,opt,name=rank_normalized,json=rankNormalized,proto3" json:"rank_normalized,omitempty"`
+	// parents are names of higher ranks implied by the name.
+	Parents []*Parent `protobuf:"bytes,27,rep,name=parents,proto3" json:"parents,omitempty"`
+}
+
+func (x *Parsed) Reset() {
+	*x = Parsed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Parsed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Parsed) ProtoMessage() {}
+
+func (x *Parsed) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Parsed.ProtoReflect.Descriptor instead.
+func (*Parsed) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Parsed) GetParsed() bool {
+	if x != nil {
+		return x.Parsed
+	}
+	return false
+}
+
+func (x *Parsed) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *Parsed) GetQualityWarnings() []*QualityWarning {
+	if x != nil {
+		return x.QualityWarnings
+	}
+	return nil
+}
+
+func (x *Parsed) GetVerbatim() string {
+	if x != nil {
+		return x.Verbatim
+	}
+	return ""
+}
+
+func (x *Parsed) GetNormalized() string {
+	if x != nil {
+		return x.Normalized
+	}
+	return ""
+}
+
+func (x *Parsed) GetCanonical() *Canonical {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+func (x *Parsed) GetCardinality() int32 {
+	if x != nil {
+		return x.Cardinality
+	}
+	return 0
+}
+
+func (x *Parsed) GetAuthorship() *Authorship {
+	if x != nil {
+		return x.Authorship
+	}
+	return nil
+}
+
+func (x *Parsed) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Parsed) GetInferredCode() *CodeInference {
+	if x != nil {
+		return x.InferredCode
+	}
+	return nil
+}
+
+func (x *Parsed) GetBacteria() string {
+	if x != nil {
+		return x.Bacteria
+	}
+	return ""
+}
+
+func (x *Parsed) GetStrain() *Strain {
+	if x != nil {
+		return x.Strain
+	}
+	return nil
+}
+
+func (x *Parsed) GetTaxonConcept() *TaxonConcept {
+	if x != nil {
+		return x.TaxonConcept
+	}
+	return nil
+}
+
+func (x *Parsed) GetNomenclaturalStatus() []*NomenclaturalStatus {
+	if x != nil {
+		return x.NomenclaturalStatus
+	}
+	return nil
+}
+
+func (x *Parsed) GetVirus() bool {
+	if x != nil {
+		return x.Virus
+	}
+	return false
+}
+
+func (x *Parsed) GetVirusCategory() string {
+	if x != nil {
+		return x.VirusCategory
+	}
+	return ""
+}
+
+func (x *Parsed) GetDaggerChar() bool {
+	if x != nil {
+		return x.DaggerChar
+	}
+	return false
+}
+
+func (x *Parsed) GetHybrid() string {
+	if x != nil {
+		return x.Hybrid
+	}
+	return ""
+}
+
+func (x *Parsed) GetGraftChimera() string {
+	if x != nil {
+		return x.GraftChimera
+	}
+	return ""
+}
+
+func (x *Parsed) GetSurrogate() string {
+	if x != nil {
+		return x.Surrogate
+	}
+	return ""
+}
+
+func (x *Parsed) GetTail() string {
+	if x != nil {
+		return x.Tail
+	}
+	return ""
+}
+
+func (x *Parsed) GetDetails() *Details {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Parsed) GetWords() []*Word {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *Parsed) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Parsed) GetParserVersion() string {
+	if x != nil {
+		return x.ParserVersion
+	}
+	return ""
+}
+
+func (x *Parsed) GetRankNormalized() string {
+	if x != nil {
+		return x.RankNormalized
+	}
+	return ""
+}
+
+func (x *Parsed) GetParents() []*Parent {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+type QualityWarning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quality int32  `protobuf:"varint,1,opt,name=quality,proto3" json:"quality,omitempty"`
+	Warning string `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
+}
+
+func (x *QualityWarning) Reset() {
+	*x = QualityWarning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QualityWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityWarning) ProtoMessage() {}
+
+func (x *QualityWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityWarning.ProtoReflect.Descriptor instead.
+func (*QualityWarning) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QualityWarning) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *QualityWarning) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+type Canonical struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stemmed string `protobuf:"bytes,1,opt,name=stemmed,proto3" json:"stemmed,omitempty"`
+	Simple  string `protobuf:"bytes,2,opt,name=simple,proto3" json:"simple,omitempty"`
+	Full    string `protobuf:"bytes,3,opt,name=full,proto3" json:"full,omitempty"`
+}
+
+func (x *Canonical) Reset() {
+	*x = Canonical{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Canonical) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Canonical) ProtoMessage() {}
+
+func (x *Canonical) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Canonical.ProtoReflect.Descriptor instead.
+func (*Canonical) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Canonical) GetStemmed() string {
+	if x != nil {
+		return x.Stemmed
+	}
+	return ""
+}
+
+func (x *Canonical) GetSimple() string {
+	if x != nil {
+		return x.Simple
+	}
+	return ""
+}
+
+func (x *Canonical) GetFull() string {
+	if x != nil {
+		return x.Full
+	}
+	return ""
+}
+
+type Parent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank       string      `protobuf:"bytes,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Normalized string      `protobuf:"bytes,2,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	Canonical  *Canonical  `protobuf:"bytes,3,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	Authorship *Authorship `protobuf:"bytes,4,opt,name=authorship,proto3" json:"authorship,omitempty"`
+}
+
+func (x *Parent) Reset() {
+	*x = Parent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Parent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Parent) ProtoMessage() {}
+
+func (x *Parent) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Parent.ProtoReflect.Descriptor instead.
+func (*Parent) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Parent) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *Parent) GetNormalized() string {
+	if x != nil {
+		return x.Normalized
+	}
+	return ""
+}
+
+func (x *Parent) GetCanonical() *Canonical {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+func (x *Parent) GetAuthorship() *Authorship {
+	if x != nil {
+		return x.Authorship
+	}
+	return nil
+}
+
+type Authorship struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verbatim      string     `protobuf:"bytes,1,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	Normalized    string     `protobuf:"bytes,2,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	Year          string     `protobuf:"bytes,3,opt,name=year,proto3" json:"year,omitempty"`
+	Authors       []string   `protobuf:"bytes,4,rep,name=authors,proto3" json:"authors,omitempty"`
+	AuthorDetails []*Author  `protobuf:"bytes,5,rep,name=author_details,json=authorDetails,proto3" json:"author_details,omitempty"`
+	Original      *AuthGroup `protobuf:"bytes,6,opt,name=original,proto3" json:"original,omitempty"`
+	Combination   *AuthGroup `protobuf:"bytes,7,opt,name=combination,proto3" json:"combination,omitempty"`
+}
+
+func (x *Authorship) Reset() {
+	*x = Authorship{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Authorship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authorship) ProtoMessage() {}
+
+func (x *Authorship) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authorship.ProtoReflect.Descriptor instead.
+func (*Authorship) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Authorship) GetVerbatim() string {
+	if x != nil {
+		return x.Verbatim
+	}
+	return ""
+}
+
+func (x *Authorship) GetNormalized() string {
+	if x != nil {
+		return x.Normalized
+	}
+	return ""
+}
+
+func (x *Authorship) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *Authorship) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *Authorship) GetAuthorDetails() []*Author {
+	if x != nil {
+		return x.AuthorDetails
+	}
+	return nil
+}
+
+func (x *Authorship) GetOriginal() *AuthGroup {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *Authorship) GetCombination() *AuthGroup {
+	if x != nil {
+		return x.Combination
+	}
+	return nil
+}
+
+type AuthGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authors       []string  `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	AuthorDetails []*Author `protobuf:"bytes,2,rep,name=author_details,json=authorDetails,proto3" json:"author_details,omitempty"`
+	Year          *Year     `protobuf:"bytes,3,opt,name=year,proto3" json:"year,omitempty"`
+	ExAuthors     *Authors  `protobuf:"bytes,4,opt,name=ex_authors,json=exAuthors,proto3" json:"ex_authors,omitempty"`
+	EmendAuthors  *Authors  `protobuf:"bytes,5,opt,name=emend_authors,json=emendAuthors,proto3" json:"emend_authors,omitempty"`
+}
+
+func (x *AuthGroup) Reset() {
+	*x = AuthGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthGroup) ProtoMessage() {}
+
+func (x *AuthGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthGroup.ProtoReflect.Descriptor instead.
+func (*AuthGroup) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AuthGroup) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *AuthGroup) GetAuthorDetails() []*Author {
+	if x != nil {
+		return x.AuthorDetails
+	}
+	return nil
+}
+
+func (x *AuthGroup) GetYear() *Year {
+	if x != nil {
+		return x.Year
+	}
+	return nil
+}
+
+func (x *AuthGroup) GetExAuthors() *Authors {
+	if x != nil {
+		return x.ExAuthors
+	}
+	return nil
+}
+
+func (x *AuthGroup) GetEmendAuthors() *Authors {
+	if x != nil {
+		return x.EmendAuthors
+	}
+	return nil
+}
+
+type Authors struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authors       []string  `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	AuthorDetails []*Author `protobuf:"bytes,2,rep,name=author_details,json=authorDetails,proto3" json:"author_details,omitempty"`
+	Year          *Year     `protobuf:"bytes,3,opt,name=year,proto3" json:"year,omitempty"`
+}
+
+func (x *Authors) Reset() {
+	*x = Authors{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Authors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authors) ProtoMessage() {}
+
+func (x *Authors) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authors.ProtoReflect.Descriptor instead.
+func (*Authors) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Authors) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *Authors) GetAuthorDetails() []*Author {
+	if x != nil {
+		return x.AuthorDetails
+	}
+	return nil
+}
+
+func (x *Authors) GetYear() *Year {
+	if x != nil {
+		return x.Year
+	}
+	return nil
+}
+
+type Author struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value     string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Initials  string `protobuf:"bytes,2,opt,name=initials,proto3" json:"initials,omitempty"`
+	Particles string `protobuf:"bytes,3,opt,name=particles,proto3" json:"particles,omitempty"`
+	Surname   string `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	Filius    bool   `protobuf:"varint,5,opt,name=filius,proto3" json:"filius,omitempty"`
+	Suffix    string `protobuf:"bytes,6,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	Standard  string `protobuf:"bytes,7,opt,name=standard,proto3" json:"standard,omitempty"`
+	Key       string `protobuf:"bytes,8,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Author) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Author) GetInitials() string {
+	if x != nil {
+		return x.Initials
+	}
+	return ""
+}
+
+func (x *Author) GetParticles() string {
+	if x != nil {
+		return x.Particles
+	}
+	return ""
+}
+
+func (x *Author) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *Author) GetFilius() bool {
+	if x != nil {
+		return x.Filius
+	}
+	return false
+}
+
+func (x *Author) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
+
+func (x *Author) GetStandard() string {
+	if x != nil {
+		return x.Standard
+	}
+	return ""
+}
+
+func (x *Author) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type Year struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value         string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	IsApproximate bool   `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty"`
+	Verbatim      string `protobuf:"bytes,3,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	Start         int32  `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32  `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *Year) Reset() {
+	*x = Year{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Year) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Year) ProtoMessage() {}
+
+func (x *Year) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Year.ProtoReflect.Descriptor instead.
+func (*Year) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Year) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Year) GetIsApproximate() bool {
+	if x != nil {
+		return x.IsApproximate
+	}
+	return false
+}
+
+func (x *Year) GetVerbatim() string {
+	if x != nil {
+		return x.Verbatim
+	}
+	return ""
+}
+
+func (x *Year) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Year) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type CodeInference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Evidence []string `protobuf:"bytes,2,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (x *CodeInference) Reset() {
+	*x = CodeInference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodeInference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeInference) ProtoMessage() {}
+
+func (x *CodeInference) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeInference.ProtoReflect.Descriptor instead.
+func (*CodeInference) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CodeInference) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CodeInference) GetEvidence() []string {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+type Strain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verbatim    string   `protobuf:"bytes,1,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	Designation string   `protobuf:"bytes,2,opt,name=designation,proto3" json:"designation,omitempty"`
+	Serovar     string   `protobuf:"bytes,3,opt,name=serovar,proto3" json:"serovar,omitempty"`
+	Pathovar    string   `protobuf:"bytes,4,opt,name=pathovar,proto3" json:"pathovar,omitempty"`
+	Biovar      string   `protobuf:"bytes,5,opt,name=biovar,proto3" json:"biovar,omitempty"`
+	Accessions  []string `protobuf:"bytes,6,rep,name=accessions,proto3" json:"accessions,omitempty"`
+}
+
+func (x *Strain) Reset() {
+	*x = Strain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Strain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Strain) ProtoMessage() {}
+
+func (x *Strain) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Strain.ProtoReflect.Descriptor instead.
+func (*Strain) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Strain) GetVerbatim() string {
+	if x != nil {
+		return x.Verbatim
+	}
+	return ""
+}
+
+func (x *Strain) GetDesignation() string {
+	if x != nil {
+		return x.Designation
+	}
+	return ""
+}
+
+func (x *Strain) GetSerovar() string {
+	if x != nil {
+		return x.Serovar
+	}
+	return ""
+}
+
+func (x *Strain) GetPathovar() string {
+	if x != nil {
+		return x.Pathovar
+	}
+	return ""
+}
+
+func (x *Strain) GetBiovar() string {
+	if x != nil {
+		return x.Biovar
+	}
+	return ""
+}
+
+func (x *Strain) GetAccessions() []string {
+	if x != nil {
+		return x.Accessions
+	}
+	return nil
+}
+
+type TaxonConcept struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verbatim  string `protobuf:"bytes,1,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	According string `protobuf:"bytes,3,opt,name=according,proto3" json:"according,omitempty"`
+	Non       string `protobuf:"bytes,4,opt,name=non,proto3" json:"non,omitempty"`
+	ProParte  bool   `protobuf:"varint,5,opt,name=pro_parte,json=proParte,proto3" json:"pro_parte,omitempty"`
+}
+
+func (x *TaxonConcept) Reset() {
+	*x = TaxonConcept{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaxonConcept) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxonConcept) ProtoMessage() {}
+
+func (x *TaxonConcept) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxonConcept.ProtoReflect.Descriptor instead.
+func (*TaxonConcept) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TaxonConcept) GetVerbatim() string {
+	if x != nil {
+		return x.Verbatim
+	}
+	return ""
+}
+
+func (x *TaxonConcept) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TaxonConcept) GetAccording() string {
+	if x != nil {
+		return x.According
+	}
+	return ""
+}
+
+func (x *TaxonConcept) GetNon() string {
+	if x != nil {
+		return x.Non
+	}
+	return ""
+}
+
+func (x *TaxonConcept) GetProParte() bool {
+	if x != nil {
+		return x.ProParte
+	}
+	return false
+}
+
+type NomenclaturalStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verbatim string `protobuf:"bytes,1,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	Status   string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *NomenclaturalStatus) Reset() {
+	*x = NomenclaturalStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NomenclaturalStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NomenclaturalStatus) ProtoMessage() {}
+
+func (x *NomenclaturalStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NomenclaturalStatus.ProtoReflect.Descriptor instead.
+func (*NomenclaturalStatus) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *NomenclaturalStatus) GetVerbatim() string {
+	if x != nil {
+		return x.Verbatim
+	}
+	return ""
+}
+
+func (x *NomenclaturalStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Word struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verbatim   string `protobuf:"bytes,1,opt,name=verbatim,proto3" json:"verbatim,omitempty"`
+	Normalized string `protobuf:"bytes,2,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	Type       string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Start      int32  `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End        int32  `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *Word) Reset() {
+	*x = Word{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Word) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Word) ProtoMessage() {}
+
+func (x *Word) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Word.ProtoReflect.Descriptor instead.
+func (*Word) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Word) GetVerbatim() string {
+	if x != nil {
+		return x.Verbatim
+	}
+	return ""
+}
+
+func (x *Word) GetNormalized() string {
+	if x != nil {
+		return x.Normalized
+	}
+	return ""
+}
+
+func (x *Word) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Word) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Word) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// Details contain one of the types of details of a name. Elements of
+// hybrid and graft-chimera formulas are Details themselves.
+type Details struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Details:
+	//	*Details_Uninomial
+	//	*Details_Species
+	//	*Details_Infraspecies
+	//	*Details_Comparison
+	//	*Details_Approximation
+	//	*Details_Virus
+	//	*Details_HybridFormula
+	//	*Details_GraftChimeraFormula
+	Details isDetails_Details `protobuf_oneof:"details"`
+}
+
+func (x *Details) Reset() {
+	*x = Details{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Details) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Details) ProtoMessage() {}
+
+func (x *Details) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Details.ProtoReflect.Descriptor instead.
+func (*Details) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *Details) GetDetails() isDetails_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (x *Details) GetUninomial() *Uninomial {
+	if x, ok := x.GetDetails().(*Details_Uninomial); ok {
+		return x.Uninomial
+	}
+	return nil
+}
+
+func (x *Details) GetSpecies() *Species {
+	if x, ok := x.GetDetails().(*Details_Species); ok {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *Details) GetInfraspecies() *Infraspecies {
+	if x, ok := x.GetDetails().(*Details_Infraspecies); ok {
+		return x.Infraspecies
+	}
+	return nil
+}
+
+func (x *Details) GetComparison() *Comparison {
+	if x, ok := x.GetDetails().(*Details_Comparison); ok {
+		return x.Comparison
+	}
+	return nil
+}
+
+func (x *Details) GetApproximation() *Approximation {
+	if x, ok := x.GetDetails().(*Details_Approximation); ok {
+		return x.Approximation
+	}
+	return nil
+}
+
+func (x *Details) GetVirus() *Virus {
+	if x, ok := x.GetDetails().(*Details_Virus); ok {
+		return x.Virus
+	}
+	return nil
+}
+
+func (x *Details) GetHybridFormula() *Formula {
+	if x, ok := x.GetDetails().(*Details_HybridFormula); ok {
+		return x.HybridFormula
+	}
+	return nil
+}
+
+func (x *Details) GetGraftChimeraFormula() *Formula {
+	if x, ok := x.GetDetails().(*Details_GraftChimeraFormula); ok {
+		return x.GraftChimeraFormula
+	}
+	return nil
+}
+
+type isDetails_Details interface {
+	isDetails_Details()
+}
+
+type Details_Uninomial struct {
+	Uninomial *Uninomial `protobuf:"bytes,1,opt,name=uninomial,proto3,oneof"`
+}
+
+type Details_Species struct {
+	Species *Species `protobuf:"bytes,2,opt,name=species,proto3,oneof"`
+}
+
+type Details_Infraspecies struct {
+	Infraspecies *Infraspecies `protobuf:"bytes,3,opt,name=infraspecies,proto3,oneof"`
+}
+
+type Details_Comparison struct {
+	Comparison *Comparison `protobuf:"bytes,4,opt,name=comparison,proto3,oneof"`
+}
+
+type Details_Approximation struct {
+	Approximation *Approximation `protobuf:"bytes,5,opt,name=approximation,proto3,oneof"`
+}
+
+type Details_Virus struct {
+	Virus *Virus `protobuf:"bytes,6,opt,name=virus,proto3,oneof"`
+}
+
+type Details_HybridFormula struct {
+	HybridFormula *Formula `protobuf:"bytes,7,opt,name=hybrid_formula,json=hybridFormula,proto3,oneof"`
+}
+
+type Details_GraftChimeraFormula struct {
+	GraftChimeraFormula *Formula `protobuf:"bytes,8,opt,name=graft_chimera_formula,json=graftChimeraFormula,proto3,oneof"`
+}
+
+func (*Details_Uninomial) isDetails_Details() {}
+
+func (*Details_Species) isDetails_Details() {}
+
+func (*Details_Infraspecies) isDetails_Details() {}
+
+func (*Details_Comparison) isDetails_Details() {}
+
+func (*Details_Approximation) isDetails_Details() {}
+
+func (*Details_Virus) isDetails_Details() {}
+
+func (*Details_HybridFormula) isDetails_Details() {}
+
+func (*Details_GraftChimeraFormula) isDetails_Details() {}
+
+type Formula struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elements []*Details `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (x *Formula) Reset() {
+	*x = Formula{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Formula) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Formula) ProtoMessage() {}
+
+func (x *Formula) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Formula.ProtoReflect.Descriptor instead.
+func (*Formula) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Formula) GetElements() []*Details {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+type Uninomial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value      string      `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Rank       string      `protobuf:"bytes,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Cultivar   string      `protobuf:"bytes,3,opt,name=cultivar,proto3" json:"cultivar,omitempty"`
+	Parent     string      `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	Authorship *Authorship `protobuf:"bytes,5,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	// rank_normalized is a rank in a controlled vocabulary, for example
+	// "SUBGENUS".
+	RankNormalized string `protobuf:"bytes,6,opt,name=rank_normalized,json=rankNormalized,proto3" json:"rank_normalized,omitempty"`
+	RankCode       string `protobuf:"bytes,7,opt,name=rank_code,json=rankCode,proto3" json:"rank_code,omitempty"`
+}
+
+func (x *Uninomial) Reset() {
+	*x = Uninomial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Uninomial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Uninomial) ProtoMessage() {}
+
+func (x *Uninomial) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Uninomial.ProtoReflect.Descriptor instead.
+func (*Uninomial) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Uninomial) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Uninomial) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *Uninomial) GetCultivar() string {
+	if x != nil {
+		return x.Cultivar
+	}
+	return ""
+}
+
+func (x *Uninomial) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *Uninomial) GetAuthorship() *Authorship {
+	if x != nil {
+		return x.Authorship
+	}
+	return nil
+}
+
+func (x *Uninomial) GetRankNormalized() string {
+	if x != nil {
+		return x.RankNormalized
+	}
+	return ""
+}
+
+func (x *Uninomial) GetRankCode() string {
+	if x != nil {
+		return x.RankCode
+	}
+	return ""
+}
+
+type Species struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Genus      string      `protobuf:"bytes,1,opt,name=genus,proto3" json:"genus,omitempty"`
+	Subgenus   string      `protobuf:"bytes,2,opt,name=subgenus,proto3" json:"subgenus,omitempty"`
+	Species    string      `protobuf:"bytes,3,opt,name=species,proto3" json:"species,omitempty"`
+	Cultivar   string      `protobuf:"bytes,4,opt,name=cultivar,proto3" json:"cultivar,omitempty"`
+	Authorship *Authorship `protobuf:"bytes,5,opt,name=authorship,proto3" json:"authorship,omitempty"`
+}
+
+func (x *Species) Reset() {
+	*x = Species{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Species) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Species) ProtoMessage() {}
+
+func (x *Species) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Species.ProtoReflect.Descriptor instead.
+func (*Species) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Species) GetGenus() string {
+	if x != nil {
+		return x.Genus
+	}
+	return ""
+}
+
+func (x *Species) GetSubgenus() string {
+	if x != nil {
+		return x.Subgenus
+	}
+	return ""
+}
+
+func (x *Species) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *Species) GetCultivar() string {
+	if x != nil {
+		return x.Cultivar
+	}
+	return ""
+}
+
+func (x *Species) GetAuthorship() *Authorship {
+	if x != nil {
+		return x.Authorship
+	}
+	return nil
+}
+
+type Infraspecies struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Species      *Species            `protobuf:"bytes,1,opt,name=species,proto3" json:"species,omitempty"`
+	Infraspecies []*InfraspeciesElem `protobuf:"bytes,2,rep,name=infraspecies,proto3" json:"infraspecies,omitempty"`
+}
+
+func (x *Infraspecies) Reset() {
+	*x = Infraspecies{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Infraspecies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Infraspecies) ProtoMessage() {}
+
+func (x *Infraspecies) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Infraspecies.ProtoReflect.Descriptor instead.
+func (*Infraspecies) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Infraspecies) GetSpecies() *Species {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *Infraspecies) GetInfraspecies() []*InfraspeciesElem {
+	if x != nil {
+		return x.Infraspecies
+	}
+	return nil
+}
+
+type InfraspeciesElem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value      string      `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Rank       string      `protobuf:"bytes,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Authorship *Authorship `protobuf:"bytes,3,opt,name=authorship,proto3" json:"authorship,omitempty"`
+	// rank_normalized is a rank in a controlled vocabulary, for example
+	// "SUBSPECIES".
+	RankNormalized string `protobuf:"bytes,4,opt,name=rank_normalized,json=rankNormalized,proto3" json:"rank_normalized,omitempty"`
+}
+
+func (x *InfraspeciesElem) Reset() {
+	*x = InfraspeciesElem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InfraspeciesElem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfraspeciesElem) ProtoMessage() {}
+
+func (x *InfraspeciesElem) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfraspeciesElem.ProtoReflect.Descriptor instead.
+func (*InfraspeciesElem) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InfraspeciesElem) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *InfraspeciesElem) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *InfraspeciesElem) GetAuthorship() *Authorship {
+	if x != nil {
+		return x.Authorship
+	}
+	return nil
+}
+
+func (x *InfraspeciesElem) GetRankNormalized() string {
+	if x != nil {
+		return x.RankNormalized
+	}
+	return ""
+}
+
+type Comparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Genus             string      `protobuf:"bytes,1,opt,name=genus,proto3" json:"genus,omitempty"`
+	Species           string      `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	Cultivar          string      `protobuf:"bytes,3,opt,name=cultivar,proto3" json:"cultivar,omitempty"`
+	SpeciesAuthorship *Authorship `protobuf:"bytes,4,opt,name=species_authorship,json=speciesAuthorship,proto3" json:"species_authorship,omitempty"`
+	ComparisonMarker  string      `protobuf:"bytes,5,opt,name=comparison_marker,json=comparisonMarker,proto3" json:"comparison_marker,omitempty"`
+}
+
+func (x *Comparison) Reset() {
+	*x = Comparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comparison) ProtoMessage() {}
+
+func (x *Comparison) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comparison.ProtoReflect.Descriptor instead.
+func (*Comparison) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Comparison) GetGenus() string {
+	if x != nil {
+		return x.Genus
+	}
+	return ""
+}
+
+func (x *Comparison) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *Comparison) GetCultivar() string {
+	if x != nil {
+		return x.Cultivar
+	}
+	return ""
+}
+
+func (x *Comparison) GetSpeciesAuthorship() *Authorship {
+	if x != nil {
+		return x.SpeciesAuthorship
+	}
+	return nil
+}
+
+func (x *Comparison) GetComparisonMarker() string {
+	if x != nil {
+		return x.ComparisonMarker
+	}
+	return ""
+}
+
+type Approximation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Genus               string      `protobuf:"bytes,1,opt,name=genus,proto3" json:"genus,omitempty"`
+	Species             string      `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	Cultivar            string      `protobuf:"bytes,3,opt,name=cultivar,proto3" json:"cultivar,omitempty"`
+	SpeciesAuthorship   *Authorship `protobuf:"bytes,4,opt,name=species_authorship,json=speciesAuthorship,proto3" json:"species_authorship,omitempty"`
+	ApproximationMarker string      `protobuf:"bytes,5,opt,name=approximation_marker,json=approximationMarker,proto3" json:"approximation_marker,omitempty"`
+	Ignored             string      `protobuf:"bytes,6,opt,name=ignored,proto3" json:"ignored,omitempty"`
+}
+
+func (x *Approximation) Reset() {
+	*x = Approximation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Approximation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Approximation) ProtoMessage() {}
+
+func (x *Approximation) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Approximation.ProtoReflect.Descriptor instead.
+func (*Approximation) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Approximation) GetGenus() string {
+	if x != nil {
+		return x.Genus
+	}
+	return ""
+}
+
+func (x *Approximation) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *Approximation) GetCultivar() string {
+	if x != nil {
+		return x.Cultivar
+	}
+	return ""
+}
+
+func (x *Approximation) GetSpeciesAuthorship() *Authorship {
+	if x != nil {
+		return x.SpeciesAuthorship
+	}
+	return nil
+}
+
+func (x *Approximation) GetApproximationMarker() string {
+	if x != nil {
+		return x.ApproximationMarker
+	}
+	return ""
+}
+
+func (x *Approximation) GetIgnored() string {
+	if x != nil {
+		return x.Ignored
+	}
+	return ""
+}
+
+type Virus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Genus   string `protobuf:"bytes,1,opt,name=genus,proto3" json:"genus,omitempty"`
+	Species string `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Strain  string `protobuf:"bytes,4,opt,name=strain,proto3" json:"strain,omitempty"`
+	Acronym string `protobuf:"bytes,5,opt,name=acronym,proto3" json:"acronym,omitempty"`
+}
+
+func (x *Virus) Reset() {
+	*x = Virus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_io_grpc_protob_gnparser_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Virus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Virus) ProtoMessage() {}
+
+func (x *Virus) ProtoReflect() protoreflect.Message {
+	mi := &file_io_grpc_protob_gnparser_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Virus.ProtoReflect.Descriptor instead.
+func (*Virus) Descriptor() ([]byte, []int) {
+	return file_io_grpc_protob_gnparser_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Virus) GetGenus() string {
+	if x != nil {
+		return x.Genus
+	}
+	return ""
+}
+
+func (x *Virus) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *Virus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Virus) GetStrain() string {
+	if x != nil {
+		return x.Strain
+	}
+	return ""
+}
+
+func (x *Virus) GetAcronym() string {
+	if x != nil {
+		return x.Acronym
+	}
+	return ""
+}
+
+var File_io_grpc_protob_gnparser_proto protoreflect.FileDescriptor
+
+var file_io_grpc_protob_gnparser_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x69, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2f, 0x67, 0x6e, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x22,
+	0x1c, 0x0a, 0x04, 0x50, 0x6f, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x39, 0x0a,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x22, 0xd8, 0x02, 0x0a, 0x07, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x69,
+	0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e,
+	0x77, 0x69, 0x74, 0x68, 0x5f, 0x63, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x43, 0x75, 0x6c, 0x74,
+	0x69, 0x76, 0x61, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x5f, 0x64, 0x69, 0x61, 0x65, 0x72, 0x65, 0x73, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x11, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x44, 0x69, 0x61, 0x65, 0x72, 0x65, 0x73, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a,
+	0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x03, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x10, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x68, 0x74, 0x6d, 0x6c,
+	0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0e, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x48, 0x74, 0x6d, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x63,
+	0x75, 0x6c, 0x74, 0x69, 0x76, 0x61, 0x72, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x64, 0x69, 0x61, 0x65, 0x72, 0x65, 0x73, 0x65, 0x73, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x13,
+	0x0a, 0x11, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x74,
+	0x61, 0x67, 0x73, 0x22, 0x4a, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x4d, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x37,
+	0x0a, 0x0b, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x28, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x85, 0x08, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x73,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x10, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x57,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x57,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x62, 0x61,
+	0x74, 0x69, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x72, 0x62, 0x61,
+	0x74, 0x69, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3a,
+	0x0a, 0x0d, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x43,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0c, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x39,
+	0x0a, 0x0d, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x54,
+	0x61, 0x78, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x0c, 0x74, 0x61, 0x78,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x4e, 0x0a, 0x14, 0x6e, 0x6f, 0x6d,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x74, 0x75, 0x72, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x4e, 0x6f, 0x6d, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x74, 0x75, 0x72, 0x61, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x13, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x74, 0x75,
+	0x72, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x72,
+	0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x69, 0x72, 0x75, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x76, 0x69, 0x72, 0x75, 0x73, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x69, 0x72, 0x75, 0x73, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x67, 0x67, 0x65, 0x72,
+	0x5f, 0x63, 0x68, 0x61, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x61, 0x67,
+	0x67, 0x65, 0x72, 0x43, 0x68, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x79, 0x62, 0x72, 0x69,
+	0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6d, 0x65, 0x72, 0x61,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x61, 0x66, 0x74, 0x43, 0x68, 0x69,
+	0x6d, 0x65, 0x72, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x72, 0x72, 0x6f, 0x67, 0x61, 0x74,
+	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x72, 0x72, 0x6f, 0x67, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x22, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x61, 0x72, 0x73, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x6b, 0x4e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x44, 0x0a, 0x0e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x51, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63,
+	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x6d, 0x6d, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x65, 0x6d, 0x6d, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x22, 0xa1, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x09, 0x63,
+	0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0x91, 0x02, 0x0a,
+	0x0a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x76,
+	0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x0d, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x08,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xe4, 0x01, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x20, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x59, 0x65, 0x61, 0x72, 0x52, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x78, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x09, 0x65, 0x78, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x73, 0x12, 0x34, 0x0a, 0x0d, 0x65, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x0c, 0x65, 0x6d, 0x65, 0x6e, 0x64,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x22, 0x7c, 0x0a, 0x07, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x0e,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x59, 0x65, 0x61, 0x72, 0x52,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0xd0, 0x01, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x69, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x69,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x87, 0x01, 0x0a, 0x04, 0x59, 0x65, 0x61,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x69, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x76, 0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x22, 0x3f, 0x0a, 0x0d, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x76, 0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x65, 0x72, 0x6f, 0x76, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x65, 0x72, 0x6f, 0x76, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x68, 0x6f, 0x76,
+	0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x6f, 0x76,
+	0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6f, 0x76, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6f, 0x76, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0c, 0x54,
+	0x61, 0x78, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76,
+	0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x63, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6e, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x50, 0x61, 0x72, 0x74, 0x65, 0x22, 0x49, 0x0a, 0x13, 0x4e, 0x6f, 0x6d, 0x65,
+	0x6e, 0x63, 0x6c, 0x61, 0x74, 0x75, 0x72, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x76, 0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x7e, 0x0a, 0x04, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76,
+	0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x22, 0xcd, 0x03, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x31, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x6e,
+	0x6f, 0x6d, 0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x6e, 0x6f, 0x6d, 0x69,
+	0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x3a, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x49,
+	0x6e, 0x66, 0x72, 0x61, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x69,
+	0x6e, 0x66, 0x72, 0x61, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
+	0x6e, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x05, 0x76, 0x69, 0x72, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x56, 0x69, 0x72, 0x75, 0x73, 0x48, 0x00,
+	0x52, 0x05, 0x76, 0x69, 0x72, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x0e, 0x68, 0x79, 0x62, 0x72, 0x69,
+	0x64, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x48, 0x00, 0x52, 0x0d, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x12, 0x45, 0x0a, 0x15, 0x67, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6d, 0x65,
+	0x72, 0x61, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x48, 0x00, 0x52, 0x13, 0x67, 0x72, 0x61, 0x66, 0x74, 0x43, 0x68, 0x69, 0x6d, 0x65, 0x72,
+	0x61, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x22, 0x36, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x2b,
+	0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xe3, 0x01, 0x0a, 0x09,
+	0x55, 0x6e, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x61, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x61, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52,
+	0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x61, 0x6e, 0x6b, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x6b, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0xa5, 0x01, 0x0a, 0x07, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x65, 0x6e, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x65,
+	0x6e, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x67, 0x65, 0x6e, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x67, 0x65, 0x6e, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x6c,
+	0x74, 0x69, 0x76, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x6c,
+	0x74, 0x69, 0x76, 0x61, 0x72, 0x12, 0x32, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0a, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0x77, 0x0a, 0x0c, 0x49, 0x6e, 0x66,
+	0x72, 0x61, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x07, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73,
+	0x45, 0x6c, 0x65, 0x6d, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x65, 0x73, 0x45, 0x6c, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x12, 0x32, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x6e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x72, 0x61, 0x6e, 0x6b, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x22, 0xc8,
+	0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x65, 0x6e, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x65,
+	0x6e, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x61, 0x72, 0x12, 0x41, 0x0a, 0x12, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x65, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x11, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x65, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2b, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x22, 0xeb, 0x01, 0x0a, 0x0d, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x65, 0x6e, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x6c, 0x74, 0x69, 0x76, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x6c, 0x74, 0x69, 0x76, 0x61, 0x72, 0x12, 0x41, 0x0a, 0x12, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x65, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x11, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x31, 0x0a, 0x14, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78,
+	0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x05, 0x56, 0x69, 0x72, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x65, 0x6e, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x63, 0x72, 0x6f, 0x6e, 0x79, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x63, 0x72, 0x6f, 0x6e, 0x79, 0x6d, 0x32, 0xf5, 0x01, 0x0a, 0x08, 0x47, 0x4e, 0x70, 0x61, 0x72,
+	0x73, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x2e, 0x50, 0x6f, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x03, 0x56, 0x65, 0x72,
+	0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x00, 0x12, 0x30, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65,
+	0x64, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x50,
+	0x61, 0x72, 0x73, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x05,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x2b,
+	0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x2f, 0x67, 0x6e, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x6f, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_io_grpc_protob_gnparser_proto_rawDescOnce sync.Once
+	file_io_grpc_protob_gnparser_proto_rawDescData = file_io_grpc_protob_gnparser_proto_rawDesc
+)
+
+func file_io_grpc_protob_gnparser_proto_rawDescGZIP() []byte {
+	file_io_grpc_protob_gnparser_proto_rawDescOnce.Do(func() {
+		file_io_grpc_protob_gnparser_proto_rawDescData = protoimpl.X.CompressGZIP(file_io_grpc_protob_gnparser_proto_rawDescData)
+	})
+	return file_io_grpc_protob_gnparser_proto_rawDescData
+}
+
+var file_io_grpc_protob_gnparser_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_io_grpc_protob_gnparser_proto_goTypes = []interface{}{
+	(*Void)(nil),                // 0: protob.Void
+	(*Pong)(nil),                // 1: protob.Pong
+	(*Version)(nil),             // 2: protob.Version
+	(*Options)(nil),             // 3: protob.Options
+	(*NameInput)(nil),           // 4: protob.NameInput
+	(*NamesInput)(nil),          // 5: protob.NamesInput
+	(*ParsedNames)(nil),         // 6: protob.ParsedNames
+	(*Parsed)(nil),              // 7: protob.Parsed
+	(*QualityWarning)(nil),      // 8: protob.QualityWarning
+	(*Canonical)(nil),           // 9: protob.Canonical
+	(*Parent)(nil),              // 10: protob.Parent
+	(*Authorship)(nil),          // 11: protob.Authorship
+	(*AuthGroup)(nil),           // 12: protob.AuthGroup
+	(*Authors)(nil),             // 13: protob.Authors
+	(*Author)(nil),              // 14: protob.Author
+	(*Year)(nil),                // 15: protob.Year
+	(*CodeInference)(nil),       // 16: protob.CodeInference
+	(*Strain)(nil),              // 17: protob.Strain
+	(*TaxonConcept)(nil),        // 18: protob.TaxonConcept
+	(*NomenclaturalStatus)(nil), // 19: protob.NomenclaturalStatus
+	(*Word)(nil),                // 20: protob.Word
+	(*Details)(nil),             // 21: protob.Details
+	(*Formula)(nil),             // 22: protob.Formula
+	(*Uninomial)(nil),           // 23: protob.Uninomial
+	(*Species)(nil),             // 24: protob.Species
+	(*Infraspecies)(nil),        // 25: protob.Infraspecies
+	(*InfraspeciesElem)(nil),    // 26: protob.InfraspeciesElem
+	(*Comparison)(nil),          // 27: protob.Comparison
+	(*Approximation)(nil),       // 28: protob.Approximation
+	(*Virus)(nil),               // 29: protob.Virus
+}
+var file_io_grpc_protob_gnparser_proto_depIdxs = []int32{
+	3,  // 0: protob.NameInput.options:type_name -> protob.Options
+	3,  // 1: protob.NamesInput.options:type_name -> protob.Options
+	7,  // 2: protob.ParsedNames.results:type_name -> protob.Parsed
+	8,  // 3: protob.Parsed.quality_warnings:type_name -> protob.QualityWarning
+	9,  // 4: protob.Parsed.canonical:type_name -> protob.Canonical
+	11, // 5: protob.Parsed.authorship:type_name -> protob.Authorship
+	16, // 6: protob.Parsed.inferred_code:type_name -> protob.CodeInference
+	17, // 7: protob.Parsed.strain:type_name -> protob.Strain
+	18, // 8: protob.Parsed.taxon_concept:type_name -> protob.TaxonConcept
+	19, // 9: protob.Parsed.nomenclatural_status:type_name -> protob.NomenclaturalStatus
+	21, // 10: protob.Parsed.details:type_name -> protob.Details
+	20, // 11: protob.Parsed.words:type_name -> protob.Word
+	10, // 12: protob.Parsed.parents:type_name -> protob.Parent
+	9,  // 13: protob.Parent.canonical:type_name -> protob.Canonical
+	11, // 14: protob.Parent.authorship:type_name -> protob.Authorship
+	14, // 15: protob.Authorship.author_details:type_name -> protob.Author
+	12, // 16: protob.Authorship.original:type_name -> protob.AuthGroup
+	12, // 17: protob.Authorship.combination:type_name -> protob.AuthGroup
+	14, // 18: protob.AuthGroup.author_details:type_name -> protob.Author
+	15, // 19: protob.AuthGroup.year:type_name -> protob.Year
+	13, // 20: protob.AuthGroup.ex_authors:type_name -> protob.Authors
+	13, // 21: protob.AuthGroup.emend_authors:type_name -> protob.Authors
+	14, // 22: protob.Authors.author_details:type_name -> protob.Author
+	15, // 23: protob.Authors.year:type_name -> protob.Year
+	23, // 24: protob.Details.uninomial:type_name -> protob.Uninomial
+	24, // 25: protob.Details.species:type_name -> protob.Species
+	25, // 26: protob.Details.infraspecies:type_name -> protob.Infraspecies
+	27, // 27: protob.Details.comparison:type_name -> protob.Comparison
+	28, // 28: protob.Details.approximation:type_name -> protob.Approximation
+	29, // 29: protob.Details.virus:type_name -> protob.Virus
+	22, // 30: protob.Details.hybrid_formula:type_name -> protob.Formula
+	22, // 31: protob.Details.graft_chimera_formula:type_name -> protob.Formula
+	21, // 32: protob.Formula.elements:type_name -> protob.Details
+	11, // 33: protob.Uninomial.authorship:type_name -> protob.Authorship
+	11, // 34: protob.Species.authorship:type_name -> protob.Authorship
+	24, // 35: protob.Infraspecies.species:type_name -> protob.Species
+	26, // 36: protob.Infraspecies.infraspecies:type_name -> protob.InfraspeciesElem
+	11, // 37: protob.InfraspeciesElem.authorship:type_name -> protob.Authorship
+	11, // 38: protob.Comparison.species_authorship:type_name -> protob.Authorship
+	11, // 39: protob.Approximation.species_authorship:type_name -> protob.Authorship
+	0,  // 40: protob.GNparser.Ping:input_type -> protob.Void
+	0,  // 41: protob.GNparser.Ver:input_type -> protob.Void
+	4,  // 42: protob.GNparser.ParseName:input_type -> protob.NameInput
+	5,  // 43: protob.GNparser.ParseNames:input_type -> protob.NamesInput
+	4,  // 44: protob.GNparser.Parse:input_type -> protob.NameInput
+	1,  // 45: protob.GNparser.Ping:output_type -> protob.Pong
+	2,  // 46: protob.GNparser.Ver:output_type -> protob.Version
+	7,  // 47: protob.GNparser.ParseName:output_type -> protob.Parsed
+	6,  // 48: protob.GNparser.ParseNames:output_type -> protob.ParsedNames
+	7,  // 49: protob.GNparser.Parse:output_type -> protob.Parsed
+	45, // [45:50] is the sub-list for method output_type
+	40, // [40:45] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
+}
+
+func init() { file_io_grpc_protob_gnparser_proto_init() }
+func file_io_grpc_protob_gnparser_proto_init() {
+	if File_io_grpc_protob_gnparser_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_io_grpc_protob_gnparser_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Void); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pong); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Version); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Options); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NameInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamesInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParsedNames); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Parsed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QualityWarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Canonical); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Parent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Authorship); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Authors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Author); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Year); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodeInference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Strain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaxonConcept); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NomenclaturalStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Word); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Details); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Formula); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Uninomial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Species); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Infraspecies); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InfraspeciesElem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Approximation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_io_grpc_protob_gnparser_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Virus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_io_grpc_protob_gnparser_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_io_grpc_protob_gnparser_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*Details_Uninomial)(nil),
+		(*Details_Species)(nil),
+		(*Details_Infraspecies)(nil),
+		(*Details_Comparison)(nil),
+		(*Details_Approximation)(nil),
+		(*Details_Virus)(nil),
+		(*Details_HybridFormula)(nil),
+		(*Details_GraftChimeraFormula)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_io_grpc_protob_gnparser_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_io_grpc_protob_gnparser_proto_goTypes,
+		DependencyIndexes: file_io_grpc_protob_gnparser_proto_depIdxs,
+		MessageInfos:      file_io_grpc_protob_gnparser_proto_msgTypes,
+	}.Build()
+	File_io_grpc_protob_gnparser_proto = out.File
+	file_io_grpc_protob_gnparser_proto_rawDesc = nil
+	file_io_grpc_protob_gnparser_proto_goTypes = nil
+	file_io_grpc_protob_gnparser_proto_depIdxs = nil
+}
@@ -0,0 +1,292 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package protob
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GNparserClient is the client API for GNparser service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GNparserClient interface {
+	// Ping checks if the service is running, returns "pong".
+	Ping(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Pong, error)
+	// Ver returns the version of GNparser.
+	Ver(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Version, error)
+	// ParseName parses one name-string.
+	ParseName(ctx context.Context, in *NameInput, opts ...grpc.CallOption) (*Parsed, error)
+	// ParseNames parses a batch of name-strings. Results follow the order
+	// of the input.
+	ParseNames(ctx context.Context, in *NamesInput, opts ...grpc.CallOption) (*ParsedNames, error)
+	// Parse parses a stream of name-strings. Results follow the order of the
+	// input. Options of the first message are used for the whole stream.
+	Parse(ctx context.Context, opts ...grpc.CallOption) (GNparser_ParseClient, error)
+}
+
+type gNparserClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGNparserClient(cc grpc.ClientConnInterface) GNparserClient {
+	return &gNparserClient{cc}
+}
+
+func (c *gNparserClient) Ping(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Pong, error) {
+	out := new(Pong)
+	err := c.cc.Invoke(ctx, "/protob.GNparser/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gNparserClient) Ver(ctx context.Context, in *Void, opts ...grpc.CallOption) (*Version, error) {
+	out := new(Version)
+	err := c.cc.Invoke(ctx, "/protob.GNparser/Ver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gNparserClient) ParseName(ctx context.Context, in *NameInput, opts ...grpc.CallOption) (*Parsed, error) {
+	out := new(Parsed)
+	err := c.cc.Invoke(ctx, "/protob.GNparser/ParseName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gNparserClient) ParseNames(ctx context.Context, in *NamesInput, opts ...grpc.CallOption) (*ParsedNames, error) {
+	out := new(ParsedNames)
+	err := c.cc.Invoke(ctx, "/protob.GNparser/ParseNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gNparserClient) Parse(ctx context.Context, opts ...grpc.CallOption) (GNparser_ParseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GNparser_ServiceDesc.Streams[0], "/protob.GNparser/Parse", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gNparserParseClient{stream}
+	return x, nil
+}
+
+type GNparser_ParseClient interface {
+	Send(*NameInput) error
+	Recv() (*Parsed, error)
+	grpc.ClientStream
+}
+
+type gNparserParseClient struct {
+	grpc.ClientStream
+}
+
+func (x *gNparserParseClient) Send(m *NameInput) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gNparserParseClient) Recv() (*Parsed, error) {
+	m := new(Parsed)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GNparserServer is the server API for GNparser service.
+// All implementations must embed UnimplementedGNparserServer
+// for forward compatibility
+type GNparserServer interface {
+	// Ping checks if the service is running, returns "pong".
+	Ping(context.Context, *Void) (*Pong, error)
+	// Ver returns the version of GNparser.
+	Ver(context.Context, *Void) (*Version, error)
+	// ParseName parses one name-string.
+	ParseName(context.Context, *NameInput) (*Parsed, error)
+	// ParseNames parses a batch of name-strings. Results follow the order
+	// of the input.
+	ParseNames(context.Context, *NamesInput) (*ParsedNames, error)
+	// Parse parses a stream of name-strings. Results follow the order of the
+	// input. Options of the first message are used for the whole stream.
+	Parse(GNparser_ParseServer) error
+	mustEmbedUnimplementedGNparserServer()
+}
+
+// UnimplementedGNparserServer must be embedded to have forward compatible implementations.
+type UnimplementedGNparserServer struct {
+}
+
+func (UnimplementedGNparserServer) Ping(context.Context, *Void) (*Pong, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedGNparserServer) Ver(context.Context, *Void) (*Version, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ver not implemented")
+}
+func (UnimplementedGNparserServer) ParseName(context.Context, *NameInput) (*Parsed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseName not implemented")
+}
+func (UnimplementedGNparserServer) ParseNames(context.Context, *NamesInput) (*ParsedNames, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseNames not implemented")
+}
+func (UnimplementedGNparserServer) Parse(GNparser_ParseServer) error {
+	return status.Errorf(codes.Unimplemented, "method Parse not implemented")
+}
+func (UnimplementedGNparserServer) mustEmbedUnimplementedGNparserServer() {}
+
+// UnsafeGNparserServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GNparserServer will
+// result in compilation errors.
+type UnsafeGNparserServer interface {
+	mustEmbedUnimplementedGNparserServer()
+}
+
+func RegisterGNparserServer(s grpc.ServiceRegistrar, srv GNparserServer) {
+	s.RegisterService(&GNparser_ServiceDesc, srv)
+}
+
+func _GNparser_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GNparserServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.GNparser/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GNparserServer).Ping(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GNparser_Ver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GNparserServer).Ver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.GNparser/Ver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GNparserServer).Ver(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GNparser_ParseName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NameInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GNparserServer).ParseName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.GNparser/ParseName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GNparserServer).ParseName(ctx, req.(*NameInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GNparser_ParseNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamesInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GNparserServer).ParseNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protob.GNparser/ParseNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GNparserServer).ParseNames(ctx, req.(*NamesInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GNparser_Parse_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GNparserServer).Parse(&gNparserParseServer{stream})
+}
+
+type GNparser_ParseServer interface {
+	Send(*Parsed) error
+	Recv() (*NameInput, error)
+	grpc.ServerStream
+}
+
+type gNparserParseServer struct {
+	grpc.ServerStream
+}
+
+func (x *gNparserParseServer) Send(m *Parsed) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gNparserParseServer) Recv() (*NameInput, error) {
+	m := new(NameInput)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GNparser_ServiceDesc is the grpc.ServiceDesc for GNparser service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GNparser_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protob.GNparser",
+	HandlerType: (*GNparserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ping",
+			Handler:    _GNparser_Ping_Handler,
+		},
+		{
+			MethodName: "Ver",
+			Handler:    _GNparser_Ver_Handler,
+		},
+		{
+			MethodName: "ParseName",
+			Handler:    _GNparser_ParseName_Handler,
+		},
+		{
+			MethodName: "ParseNames",
+			Handler:    _GNparser_ParseNames_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Parse",
+			Handler:       _GNparser_Parse_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "io/grpc/protob/gnparser.proto",
+}
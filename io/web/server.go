@@ -10,6 +10,7 @@ import (
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/nomcode"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -23,9 +24,14 @@ var static embed.FS
 type inputREST struct {
 	Names             []string `json:"names"`
 	CSV               bool     `json:"csv"`
+	DwC               bool     `json:"dwc"`
+	HTML              bool     `json:"html"`
+	Markdown          bool     `json:"markdown"`
 	WithDetails       bool     `json:"withDetails"`
 	WithCultivars     bool     `json:"withCultivars"`
 	PreserveDiaereses bool     `json:"preserveDiaereses"`
+	Code              string   `json:"code"`
+	Fields            []string `json:"fields"`
 }
 
 // Run starts the GNparser web service and servies both RESTful API and
@@ -97,13 +103,24 @@ func parseNamesGET(gnps GNparserService) func(echo.Context) error {
 	return func(c echo.Context) error {
 		nameStr, _ := url.QueryUnescape(c.Param("names"))
 		csv := c.QueryParam("csv") == "true"
+		dwc := c.QueryParam("dwc") == "true"
+		html := c.QueryParam("html") == "true"
+		md := c.QueryParam("markdown") == "true"
 		det := c.QueryParam("with_details") == "true"
 		cultivars := c.QueryParam("cultivars") == "true"
 		diaereses := c.QueryParam("diaereses") == "true"
-		gnp := gnps.ChangeConfig(opts(c, csv, det, cultivars, diaereses)...)
+		code := c.QueryParam("code")
+		fields, err := parsed.NewFields(c.QueryParam("fields"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		gnp := gnps.ChangeConfig(
+			opts(c, restFormat(csv, dwc, html, md), det, cultivars, diaereses, code)...,
+		)
+		gnp = gnp.ChangeConfig(gnparser.OptFields(fields))
 		names := strings.Split(nameStr, "|")
 		res := gnp.ParseNames(names)
-		return formatNames(c, res, gnp.Format())
+		return formatNames(c, res, gnp.Format(), gnp.Fields())
 	}
 }
 
@@ -113,9 +130,15 @@ func parseNamesPOST(gnps GNparserService) func(echo.Context) error {
 		if err := c.Bind(&input); err != nil {
 			return err
 		}
-		gnp := gnps.ChangeConfig(opts(c, input.CSV, input.WithDetails, input.WithCultivars, input.PreserveDiaereses)...)
+		fields, err := parsed.NewFields(strings.Join(input.Fields, ","))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		f := restFormat(input.CSV, input.DwC, input.HTML, input.Markdown)
+		gnp := gnps.ChangeConfig(opts(c, f, input.WithDetails, input.WithCultivars, input.PreserveDiaereses, input.Code)...)
+		gnp = gnp.ChangeConfig(gnparser.OptFields(fields))
 		res := gnp.ParseNames(input.Names)
-		return formatNames(c, res, gnp.Format())
+		return formatNames(c, res, gnp.Format(), gnp.Fields())
 	}
 }
 
@@ -123,32 +146,52 @@ func formatNames(
 	c echo.Context,
 	res []parsed.Parsed,
 	f gnfmt.Format,
+	fields []parsed.Field,
 ) error {
 
 	switch f {
-	case gnfmt.CSV, gnfmt.TSV:
+	case gnfmt.CSV, gnfmt.TSV, parsed.DwC:
 		resCSV := make([]string, 0, len(res)+1)
-		resCSV = append(resCSV, parsed.HeaderCSV(f))
+		resCSV = append(resCSV, parsed.HeaderCSV(f, fields...))
 		for i := range res {
-			resCSV = append(resCSV, res[i].Output(f))
+			resCSV = append(resCSV, res[i].Output(f, fields...))
 		}
 		return c.String(http.StatusOK, strings.Join(resCSV, "\n"))
+	case parsed.HTML, parsed.Markdown:
+		resMarkup := make([]string, len(res))
+		for i := range res {
+			resMarkup[i] = res[i].Output(f)
+		}
+		return c.String(http.StatusOK, strings.Join(resMarkup, "\n"))
 	default:
 		return c.JSON(http.StatusOK, res)
 	}
 }
 
-func opts(c echo.Context, csv, details, cultivars bool, diaereses bool) []gnparser.Option {
+// restFormat returns the output format of RESTful API. If several formats
+// are requested, DwC goes first, then HTML, Markdown, and CSV.
+func restFormat(csv, dwc, html, md bool) string {
+	switch {
+	case dwc:
+		return "dwc"
+	case html:
+		return "html"
+	case md:
+		return "markdown"
+	case csv:
+		return "csv"
+	default:
+		return "compact"
+	}
+}
+
+func opts(c echo.Context, format string, details, cultivars bool, diaereses bool, code string) []gnparser.Option {
 	res := []gnparser.Option{
 		gnparser.OptWithDetails(details),
 		gnparser.OptWithCultivars(cultivars),
 		gnparser.OptWithPreserveDiaereses(diaereses),
+		gnparser.OptCode(nomcode.New(code)),
+		gnparser.OptFormat(format),
 	}
-	if csv {
-		res = append(res, gnparser.OptFormat("csv"))
-	} else {
-		res = append(res, gnparser.OptFormat("compact"))
-	}
-
 	return res
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/nomcode"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/labstack/echo/v4"
 )
@@ -20,6 +21,7 @@ type inputFORM struct {
 	WithDetails       string `query:"with_details" form:"with_details"`
 	WithCultivars     string `query:"cultivars" form:"cultivars"`
 	PreserveDiaereses string `query:"diaereses" form:"diaereses"`
+	Code              string `query:"code" form:"code"`
 }
 
 // Data contains information required to render web-pages.
@@ -32,6 +34,7 @@ type Data struct {
 	WithDetails       bool
 	WithCultivars     bool
 	PreserveDiaereses bool
+	Code              string
 }
 
 // NewData creates new Data for web-page templates.
@@ -77,6 +80,9 @@ func redirectToHomeGET(c echo.Context, inp *inputFORM) error {
 	if preserveDiaereses {
 		q.Set("diaereses", inp.PreserveDiaereses)
 	}
+	if inp.Code != "" {
+		q.Set("code", inp.Code)
+	}
 
 	url := fmt.Sprintf("/?%s", q.Encode())
 	return c.Redirect(http.StatusFound, url)
@@ -110,9 +116,11 @@ func parsingResults(
 	data.WithDetails = inp.WithDetails == "on"
 	data.WithCultivars = inp.WithCultivars == "on"
 	data.PreserveDiaereses = inp.PreserveDiaereses == "on"
+	data.Code = inp.Code
 
 	format := inp.Format
-	if format == "csv" || format == "tsv" || format == "json" {
+	switch format {
+	case "csv", "tsv", "dwc", "json", "html_names", "markdown":
 		data.Format = format
 	}
 
@@ -132,6 +140,13 @@ func parsingResults(
 		gnparser.OptWithDetails(data.WithDetails),
 		gnparser.OptWithCultivars(data.WithCultivars),
 		gnparser.OptWithPreserveDiaereses(data.PreserveDiaereses),
+		gnparser.OptCode(nomcode.New(data.Code)),
+	}
+	switch data.Format {
+	case "dwc", "markdown":
+		opts = append(opts, gnparser.OptFormat(data.Format))
+	case "html_names":
+		opts = append(opts, gnparser.OptFormat("html"))
 	}
 
 	gnp := gnps.ChangeConfig(opts...)
@@ -140,10 +155,13 @@ func parsingResults(
 	switch data.Format {
 	case "json":
 		return c.JSON(http.StatusOK, data.Parsed)
-	case "csv", "tsv":
+	case "csv", "tsv", "dwc":
 		f := gnfmt.CSV
-		if data.Format == "tsv" {
+		switch data.Format {
+		case "tsv":
 			f = gnfmt.TSV
+		case "dwc":
+			f = parsed.DwC
 		}
 
 		res := make([]string, len(data.Parsed)+1)
@@ -152,6 +170,17 @@ func parsingResults(
 			res[i+1] = data.Parsed[i].Output(f)
 		}
 		return c.String(http.StatusOK, strings.Join(res, "\n"))
+	case "html_names", "markdown":
+		f := parsed.Markdown
+		if data.Format == "html_names" {
+			f = parsed.HTML
+		}
+
+		res := make([]string, len(data.Parsed))
+		for i := range data.Parsed {
+			res[i] = data.Parsed[i].Output(f)
+		}
+		return c.String(http.StatusOK, strings.Join(res, "\n"))
 	default:
 		return c.Render(http.StatusOK, "layout", data)
 	}
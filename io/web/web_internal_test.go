@@ -11,6 +11,7 @@ import (
   "github.com/gnames/gnfmt"
   "github.com/gnames/gnlib/ent/gnvers"
   "github.com/gnames/gnparser"
+  "github.com/gnames/gnparser/ent/nomcode"
   "github.com/gnames/gnparser/ent/parsed"
   "github.com/labstack/echo/v4"
   "github.com/stretchr/testify/assert"
@@ -166,6 +167,129 @@ func TestParseParamsGET(t *testing.T) {
   }
 }
 
+func TestParseCodeGET(t *testing.T) {
+  cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
+  gnp := gnparser.New(cfg)
+  gnps := NewGNparserService(gnp, 0)
+
+  name := url.QueryEscape("Aus bus L.f. cus")
+  tests := []struct {
+    code, norm string
+    res        nomcode.Code
+  }{
+    {"", "Aus bus L. fil. cus", nomcode.Unknown},
+    {"bot", "Aus bus L. f. cus", nomcode.Botanical},
+    {"ICN", "Aus bus L. f. cus", nomcode.Botanical},
+    {"zoo", "Aus bus L. fil. cus", nomcode.Zoological},
+  }
+
+  for _, v := range tests {
+    var response []parsed.Parsed
+    e := echo.New()
+    q := make(url.Values)
+    q.Set("code", v.code)
+    req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+    rec := httptest.NewRecorder()
+    c := e.NewContext(req, rec)
+    c.SetPath("/:names")
+    c.SetParamNames("names")
+    c.SetParamValues(name)
+
+    assert.Nil(t, parseNamesGET(gnps)(c))
+
+    err := gnfmt.GNjson{}.Decode(rec.Body.Bytes(), &response)
+    assert.Nil(t, err)
+    assert.Equal(t, response[0].Normalized, v.norm, v.code)
+    assert.Equal(t, response[0].Code, v.res, v.code)
+  }
+}
+
+func TestParseDwCGET(t *testing.T) {
+  cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
+  gnp := gnparser.New(cfg)
+  gnps := NewGNparserService(gnp, 0)
+
+  name := url.QueryEscape("Aus bus var. cus (L.) Mill. 1768")
+  e := echo.New()
+  q := make(url.Values)
+  q.Set("dwc", "true")
+  req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+  rec := httptest.NewRecorder()
+  c := e.NewContext(req, rec)
+  c.SetPath("/:names")
+  c.SetParamNames("names")
+  c.SetParamValues(name)
+
+  assert.Nil(t, parseNamesGET(gnps)(c))
+
+  lines := strings.Split(rec.Body.String(), "\n")
+  assert.Equal(t, len(lines), 2)
+  assert.True(t, strings.HasPrefix(lines[0], "id,scientificName,"))
+  assert.True(t, strings.HasSuffix(lines[1],
+    ",Aus,,bus,cus,variety,var.,1768,"))
+}
+
+func TestParseHTMLGET(t *testing.T) {
+  cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
+  gnp := gnparser.New(cfg)
+  gnps := NewGNparserService(gnp, 0)
+
+  tests := []struct {
+    param, res string
+  }{
+    {"html", "<i>Aus bus</i> L. &amp; Smith var. <i>cus</i>\n<i>Dus</i> cf. <i>eus</i>"},
+    {"markdown", "*Aus bus* L. & Smith var. *cus*\n*Dus* cf. *eus*"},
+  }
+  for _, v := range tests {
+    name := url.QueryEscape("Aus bus L. & Smith var. cus|Dus cf. eus")
+    e := echo.New()
+    q := make(url.Values)
+    q.Set(v.param, "true")
+    req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+    rec := httptest.NewRecorder()
+    c := e.NewContext(req, rec)
+    c.SetPath("/:names")
+    c.SetParamNames("names")
+    c.SetParamValues(name)
+
+    assert.Nil(t, parseNamesGET(gnps)(c))
+    assert.Equal(t, rec.Body.String(), v.res, v.param)
+  }
+}
+
+func TestParseFieldsGET(t *testing.T) {
+  cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
+  gnp := gnparser.New(cfg)
+  gnps := NewGNparserService(gnp, 0)
+
+  name := url.QueryEscape("Aus bus var. cus L.")
+  tests := []struct {
+    fields, res string
+    err         bool
+  }{
+    {"genus,species,infraspecies,rank,authors",
+      "Genus,Species,Infraspecies,Rank,Authors\nAus,bus,cus,var.,L.", false},
+    {"genus,unknown", "", true},
+  }
+
+  for _, v := range tests {
+    e := echo.New()
+    q := make(url.Values)
+    q.Set("csv", "true")
+    q.Set("fields", v.fields)
+    req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+    rec := httptest.NewRecorder()
+    c := e.NewContext(req, rec)
+    c.SetPath("/:names")
+    c.SetParamNames("names")
+    c.SetParamValues(name)
+
+    err := parseNamesGET(gnps)(c)
+    assert.Equal(t, err != nil, v.err, v.fields)
+    assert.Equal(t, rec.Body.String(), v.res, v.fields)
+  }
+}
+
 func TestParsePOST(t *testing.T) {
   cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
   gnp := gnparser.New(cfg)